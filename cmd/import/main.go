@@ -0,0 +1,111 @@
+// Command import loads a CSV or JSON export from an external hackathon platform into the hub.
+//
+//	go run ./cmd/import -source devpost -file entries.csv -mapping devpost.json -dry-run
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"monad-devhub-be/internal/config"
+	"monad-devhub-be/internal/database"
+	"monad-devhub-be/internal/importer"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/services"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	filePath := flag.String("file", "", "path to the CSV or JSON export")
+	mappingPath := flag.String("mapping", "", "path to the JSON column-mapping config")
+	source := flag.String("source", "", "name of the external platform, e.g. devpost")
+	target := flag.String("target", services.ImportTargetSubmissions, "create submissions or approved projects")
+	format := flag.String("format", "", "csv or json (defaults to the file extension)")
+	dryRun := flag.Bool("dry-run", false, "validate and report without creating anything")
+	flag.Parse()
+
+	if *filePath == "" || *mappingPath == "" || *source == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// Load environment variables
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found, using system environment variables")
+	}
+	cfg := config.Load()
+
+	importFormat, err := importer.ParseFormat(*format, *filePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	records, err := readRecords(importFormat, *filePath)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", *filePath, err)
+	}
+	mapping, err := readMapping(*mappingPath)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", *mappingPath, err)
+	}
+
+	// Initialize database
+	db, err := database.Initialize(cfg.DatabaseURL())
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+	if err := database.Migrate(db); err != nil {
+		log.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	projectRepo := repository.NewProjectRepository(db)
+	submissionRepo := repository.NewSubmissionRepository(db)
+	eventRepo := repository.NewEventRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	awardRepo := repository.NewAwardRepository(db)
+	projectService := services.NewProjectService(projectRepo, submissionRepo, eventRepo, categoryRepo)
+	importService := services.NewImportService(projectService, submissionRepo, projectRepo, awardRepo)
+
+	report, err := importService.Import(&services.ImportRequest{
+		Source:  *source,
+		Target:  *target,
+		DryRun:  *dryRun,
+		Mapping: mapping,
+		Records: records,
+	})
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("%d rows: %d created, %d existing, %d duplicates, %d invalid, %d failed",
+		report.Total, report.Created, report.Existing, report.Duplicates, report.Invalid, report.Failed)
+	if report.HasProblems() {
+		os.Exit(1)
+	}
+}
+
+func readRecords(format importer.Format, path string) ([]importer.Record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return importer.ReadRecords(format, file)
+}
+
+func readMapping(path string) (*importer.Mapping, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return importer.LoadMapping(file)
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Config holds all application configuration
@@ -19,6 +20,47 @@ type Config struct {
 	JWTSecret          string
 	CORSOrigins        []string
 	RateLimitPerMinute int
+	DraftTTL           time.Duration
+	ReviewSLADays      int
+	SLAHolidays        []string
+	SLALocation        *time.Location
+	Storage            StorageConfig
+	MaxImageBytes      int64
+	MaxVideoBytes      int64
+	UploadsPerMinute   int           // Submission image uploads one IP address may make per minute
+	UploadTTL          time.Duration // How long an unreferenced submission image is kept
+	Comments           CommentConfig
+	LikesPerIP         int // Anonymous likes one IP address may give a single project
+	SIWE               SIWEConfig
+	AutoApproveFields  []string // Project fields whose owner edits skip review
+}
+
+// SIWEConfig holds what Sign-In with Ethereum messages must match
+type SIWEConfig struct {
+	Domains    []string // Hosts of the frontends builders sign in from
+	ChainIDs   []int64  // Accepted chain IDs; any chain when empty
+	SessionTTL time.Duration
+}
+
+// CommentConfig holds the spam and abuse limits for project comments
+type CommentConfig struct {
+	PerMinute    int      // Comments one author may post per minute
+	PerHourPerIP int      // Comments one IP address may post per hour
+	BlockedWords []string // Added to the built-in profanity list
+	AllowLinks   bool
+}
+
+// StorageConfig selects and configures the media storage backend
+type StorageConfig struct {
+	Driver            string // "local" or "s3"
+	LocalDir          string
+	PublicURL         string // Base URL that stored files are served from; derived per driver when empty
+	S3Endpoint        string
+	S3Region          string
+	S3Bucket          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	S3ForcePathStyle  bool
 }
 
 // Load reads configuration from environment variables
@@ -53,9 +95,93 @@ func Load() *Config {
 	}
 	cfg.RateLimitPerMinute = rateLimit
 
+	// Parse draft expiry
+	draftTTLStr := getEnv("DRAFT_TTL_HOURS", "168")
+	draftTTLHours, err := strconv.Atoi(draftTTLStr)
+	if err != nil || draftTTLHours <= 0 {
+		draftTTLHours = 168
+	}
+	cfg.DraftTTL = time.Duration(draftTTLHours) * time.Hour
+
+	// Parse review SLA settings
+	reviewSLADays, err := strconv.Atoi(getEnv("REVIEW_SLA_BUSINESS_DAYS", "3"))
+	if err != nil || reviewSLADays <= 0 {
+		reviewSLADays = 3
+	}
+	cfg.ReviewSLADays = reviewSLADays
+	cfg.SLAHolidays = strings.Split(getEnv("SLA_HOLIDAYS", ""), ",")
+	cfg.SLALocation, err = time.LoadLocation(getEnv("SLA_TIMEZONE", "UTC"))
+	if err != nil {
+		cfg.SLALocation = time.UTC
+	}
+
+	// Parse media storage settings
+	cfg.Storage = StorageConfig{
+		Driver:            getEnv("STORAGE_DRIVER", "local"),
+		LocalDir:          getEnv("STORAGE_LOCAL_DIR", "./uploads"),
+		PublicURL:         strings.TrimSuffix(getEnv("STORAGE_PUBLIC_URL", ""), "/"),
+		S3Endpoint:        getEnv("S3_ENDPOINT", ""),
+		S3Region:          getEnv("S3_REGION", "us-east-1"),
+		S3Bucket:          getEnv("S3_BUCKET", ""),
+		S3AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+		S3SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+		S3ForcePathStyle:  getEnv("S3_FORCE_PATH_STYLE", "false") == "true",
+	}
+	if cfg.Storage.Driver == "local" && cfg.Storage.PublicURL == "" {
+		cfg.Storage.PublicURL = "http://localhost:" + cfg.Port + "/media"
+	}
+	cfg.MaxImageBytes = getEnvMegabytes("UPLOAD_MAX_IMAGE_MB", 5)
+	cfg.MaxVideoBytes = getEnvMegabytes("UPLOAD_MAX_VIDEO_MB", 100)
+	cfg.UploadsPerMinute = getEnvPositiveInt("UPLOAD_RATE_LIMIT_PER_MINUTE", 10)
+	cfg.UploadTTL = time.Duration(getEnvPositiveInt("UPLOAD_TTL_HOURS", 24)) * time.Hour
+
+	// Parse comment moderation settings
+	cfg.Comments = CommentConfig{
+		PerMinute:    getEnvPositiveInt("COMMENT_RATE_LIMIT_PER_MINUTE", 3),
+		PerHourPerIP: getEnvPositiveInt("COMMENT_RATE_LIMIT_PER_HOUR_PER_IP", 30),
+		BlockedWords: strings.Split(getEnv("COMMENT_BLOCKED_WORDS", ""), ","),
+		AllowLinks:   getEnv("COMMENT_ALLOW_LINKS", "false") == "true",
+	}
+	cfg.LikesPerIP = getEnvPositiveInt("LIKES_PER_IP_PER_PROJECT", 20)
+
+	cfg.AutoApproveFields = strings.Split(getEnv("CHANGE_AUTO_APPROVE_FIELDS", ""), ",")
+
+	// Parse Sign-In with Ethereum settings
+	cfg.SIWE = SIWEConfig{
+		SessionTTL: time.Duration(getEnvPositiveInt("BUILDER_SESSION_HOURS", 24)) * time.Hour,
+	}
+	for _, domain := range strings.Split(getEnv("SIWE_DOMAINS", "localhost:3000"), ",") {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			cfg.SIWE.Domains = append(cfg.SIWE.Domains, domain)
+		}
+	}
+	for _, chainID := range strings.Split(getEnv("SIWE_CHAIN_IDS", ""), ",") {
+		if id, err := strconv.ParseInt(strings.TrimSpace(chainID), 10, 64); err == nil && id > 0 {
+			cfg.SIWE.ChainIDs = append(cfg.SIWE.ChainIDs, id)
+		}
+	}
+
 	return cfg
 }
 
+// getEnvPositiveInt reads a positive integer, falling back when it is missing or invalid
+func getEnvPositiveInt(key string, fallback int) int {
+	value, err := strconv.Atoi(getEnv(key, strconv.Itoa(fallback)))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+// getEnvMegabytes reads a positive size in megabytes and returns it in bytes
+func getEnvMegabytes(key string, fallback int) int64 {
+	megabytes, err := strconv.Atoi(getEnv(key, strconv.Itoa(fallback)))
+	if err != nil || megabytes <= 0 {
+		megabytes = fallback
+	}
+	return int64(megabytes) << 20
+}
+
 // DatabaseURL returns the PostgreSQL connection string
 func (c *Config) DatabaseURL() string {
 	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
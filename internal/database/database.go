@@ -1,19 +1,25 @@
 package database
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"time"
 
 	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/utils"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 )
 
 // Initialize creates a new database connection
 func Initialize(databaseURL string) (*gorm.DB, error) {
 	db, err := gorm.Open(postgres.Open(databaseURL), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger:         logger.Default.LogMode(logger.Info),
+		TranslateError: true, // Surface unique violations as gorm.ErrDuplicatedKey
 	})
 	if err != nil {
 		return nil, err
@@ -27,21 +33,436 @@ func Initialize(databaseURL string) (*gorm.DB, error) {
 func Migrate(db *gorm.DB) error {
 	log.Println("Running database migrations...")
 
+	// Likes counted before per-voter likes existed are kept as legacy likes
+	seedLegacyLikes := db.Migrator().HasTable(&models.Project{}) && !db.Migrator().HasColumn(&models.Project{}, "legacy_likes")
+
+	// Projects created before scheduled publishing existed were visible immediately. Backfilled only when the column
+	// is added: later projects with neither date set are unpublished drafts
+	backfillPublishedAt := db.Migrator().HasTable(&models.Project{}) && !db.Migrator().HasColumn(&models.Project{}, "published_at")
+
 	err := db.AutoMigrate(
+		&dataMigration{},
+		&models.Event{},
+		&models.Category{},
 		&models.Project{},
+		&models.Award{},
+		&models.ProjectAward{},
+		&models.Builder{},
 		&models.TeamMember{},
+		&models.ProjectMedia{},
+		&models.Upload{},
 		&models.Submission{},
 		&models.AnalyticsStats{},
 		&models.Transaction{},
 		&models.Contract{},
 		&models.ContractStats{},
+		&models.ProjectContract{},
+		&models.ProjectLike{},
+		&models.Comment{},
+		&models.CommentBan{},
 		&models.AdminUser{},
+		&models.BuilderAccount{},
+		&models.SiweNonce{},
+		&models.ProjectChangeRequest{},
+		&models.ProjectChangeLog{},
+		&models.Collection{},
+		&models.CollectionProject{},
+		&models.SubmissionDraft{},
 	)
 
 	if err != nil {
 		return err
 	}
 
+	if backfillPublishedAt {
+		err = db.Exec("UPDATE projects SET published_at = created_at WHERE published_at IS NULL AND publish_at IS NULL").Error
+		if err != nil {
+			return err
+		}
+	}
+
+	// Project activity looks transactions up by recipient regardless of address case
+	err = db.Exec("CREATE INDEX IF NOT EXISTS idx_transactions_to_address_lower ON transactions (lower(to_address), timestamp)").Error
+	if err != nil {
+		return err
+	}
+
+	if err := runOnce(db, "link_events", migrateEvents); err != nil {
+		return err
+	}
+	// Judging tables are migrated once their rows are linked to events, since event_id is NOT NULL
+	if err := runOnce(db, "link_judging_events", migrateJudgingEvents); err != nil {
+		return err
+	}
+	if err := db.AutoMigrate(&models.JudgingCriterion{}, &models.JudgeAssignment{}, &models.JudgeScore{}); err != nil {
+		return err
+	}
+
+	if err := runOnce(db, "seed_categories", migrateCategories); err != nil {
+		return err
+	}
+
+	if err := migrateAwards(db); err != nil {
+		return err
+	}
+
+	if err := migrateProjectSearch(db); err != nil {
+		return err
+	}
+
+	if seedLegacyLikes {
+		if err := db.Exec("UPDATE projects SET legacy_likes = likes").Error; err != nil {
+			return err
+		}
+	}
+	if err := runOnce(db, "reconcile_counters", reconcileCounters); err != nil {
+		return err
+	}
+	if err := runOnce(db, "link_builders", linkBuilders); err != nil {
+		return err
+	}
+
 	log.Println("Database migrations completed")
 	return nil
 }
+
+// dataMigration records a one-off data migration that has been applied
+type dataMigration struct {
+	Name      string `gorm:"primaryKey"`
+	AppliedAt time.Time
+}
+
+func (dataMigration) TableName() string {
+	return "data_migrations"
+}
+
+// runOnce applies a one-off data migration in a transaction and records it so that it never runs again.
+// Instances starting at the same time wait on each other's record, so only one of them applies it
+func runOnce(db *gorm.DB, name string, migrate func(tx *gorm.DB) error) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&dataMigration{Name: name, AppliedAt: time.Now()})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		log.Printf("Applying data migration %s", name)
+		if err := migrate(tx); err != nil {
+			return fmt.Errorf("data migration %s failed: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// projectSearchStatements maintain the weighted full-text document of each project: name (A), categories and
+// team member names (B), description (C) and how-to-play (D). Triggers keep it current, including when the team
+// changes, and a trigram index on names backs fuzzy matching of misspelled searches.
+var projectSearchStatements = []string{
+	`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+	`ALTER TABLE projects ADD COLUMN IF NOT EXISTS search_vector tsvector`,
+	`CREATE OR REPLACE FUNCTION project_search_document(p projects) RETURNS tsvector AS $$
+		SELECT setweight(to_tsvector('english', coalesce(p.name, '')), 'A') ||
+			setweight(to_tsvector('english', coalesce(array_to_string(p.categories, ' '), '')), 'B') ||
+			setweight(to_tsvector('simple', coalesce((SELECT string_agg(team_members.name, ' ') FROM team_members WHERE team_members.project_id = p.id), '')), 'B') ||
+			setweight(to_tsvector('english', coalesce(p.description, '')), 'C') ||
+			setweight(to_tsvector('english', coalesce(p.how_to_play, '')), 'D')
+	$$ LANGUAGE sql STABLE`,
+	`CREATE OR REPLACE FUNCTION projects_search_vector_trigger() RETURNS trigger AS $$
+	BEGIN
+		NEW.search_vector := project_search_document(NEW);
+		RETURN NEW;
+	END
+	$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS projects_search_vector_update ON projects`,
+	`CREATE TRIGGER projects_search_vector_update BEFORE INSERT OR UPDATE OF name, description, how_to_play, categories
+		ON projects FOR EACH ROW EXECUTE FUNCTION projects_search_vector_trigger()`,
+	`CREATE OR REPLACE FUNCTION team_members_search_vector_trigger() RETURNS trigger AS $$
+	BEGIN
+		IF TG_OP <> 'INSERT' THEN
+			UPDATE projects SET search_vector = project_search_document(projects) WHERE id = OLD.project_id;
+		END IF;
+		IF TG_OP <> 'DELETE' THEN
+			UPDATE projects SET search_vector = project_search_document(projects) WHERE id = NEW.project_id;
+		END IF;
+		RETURN NULL;
+	END
+	$$ LANGUAGE plpgsql`,
+	`DROP TRIGGER IF EXISTS team_members_search_vector_update ON team_members`,
+	`CREATE TRIGGER team_members_search_vector_update AFTER INSERT OR UPDATE OF name, project_id OR DELETE
+		ON team_members FOR EACH ROW EXECUTE FUNCTION team_members_search_vector_trigger()`,
+	`UPDATE projects SET search_vector = project_search_document(projects) WHERE search_vector IS NULL`,
+	`CREATE INDEX IF NOT EXISTS idx_projects_search_vector ON projects USING gin (search_vector)`,
+	`CREATE INDEX IF NOT EXISTS idx_projects_name_trgm ON projects USING gin (name gin_trgm_ops)`,
+}
+
+// migrateProjectSearch sets up full-text and fuzzy search over projects
+func migrateProjectSearch(db *gorm.DB) error {
+	for _, statement := range projectSearchStatements {
+		if err := db.Exec(statement).Error; err != nil {
+			return fmt.Errorf("project search migration failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// defaultEvents are the events that were hardcoded before events became configurable
+var defaultEvents = []string{
+	"Mission: 1 Crazy Contract",
+	"Mission: 2 MCP Madness",
+	"Mission: 3 Break Monad V2",
+	"Mission: 4 Visualizer & Dashboard",
+	"Hackathon",
+	"Free Will!!!",
+}
+
+// migrateEvents seeds the events table and links existing projects and submissions to it by event name.
+// Names no event has are given a closed event
+func migrateEvents(db *gorm.DB) error {
+	var count int64
+	if err := db.Model(&models.Event{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		for _, name := range defaultEvents {
+			if err := createEventForName(db, name, "active"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := createClosedEvents(db, "SELECT event FROM projects UNION SELECT event FROM submissions"); err != nil {
+		return err
+	}
+
+	for _, table := range []string{"projects", "submissions"} {
+		err := db.Exec("UPDATE " + table + " SET event_id = events.id FROM events WHERE " + table + ".event = events.name AND " + table + ".event_id IS NULL").Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateJudgingEvents links rubric criteria and judge assignments, which used to store the event name, to their event
+// by ID, and drops the event name that awards used to store. It runs before the judging tables are auto-migrated,
+// so the event_id column they declare NOT NULL is added and filled here
+func migrateJudgingEvents(db *gorm.DB) error {
+	for _, model := range []interface{}{&models.JudgingCriterion{}, &models.JudgeAssignment{}} {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return err
+		}
+		table := stmt.Schema.Table
+
+		if db.Migrator().HasColumn(model, "event") {
+			if err := db.Exec("ALTER TABLE " + table + " ADD COLUMN IF NOT EXISTS event_id bigint").Error; err != nil {
+				return err
+			}
+			if err := createClosedEvents(db, "SELECT event FROM "+table); err != nil {
+				return err
+			}
+			err := db.Exec("UPDATE " + table + " SET event_id = events.id FROM events WHERE " + table + ".event = events.name").Error
+			if err != nil {
+				return err
+			}
+			if err := db.Migrator().DropColumn(model, "event"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if db.Migrator().HasColumn(&models.Award{}, "event") {
+		return db.Migrator().DropColumn(&models.Award{}, "event")
+	}
+	return nil
+}
+
+// createClosedEvents creates a closed event for every name returned by the query that has no event yet,
+// so that every row can be linked
+func createClosedEvents(db *gorm.DB, query string) error {
+	var names []string
+	err := db.Raw(`
+		SELECT DISTINCT event FROM (` + query + `) used
+		WHERE event <> '' AND event NOT IN (SELECT name FROM events)`).Scan(&names).Error
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := createEventForName(db, name, "closed"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createEventForName creates an event with a unique slug derived from its name
+func createEventForName(db *gorm.DB, name, status string) error {
+	base := utils.Slugify(name)
+	if base == "" {
+		base = "event"
+	}
+
+	slug := base
+	for i := 2; ; i++ {
+		var taken int64
+		if err := db.Model(&models.Event{}).Where("slug = ?", slug).Count(&taken).Error; err != nil {
+			return err
+		}
+		if taken == 0 {
+			break
+		}
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+
+	return db.Create(&models.Event{Slug: slug, Name: name, Status: status}).Error
+}
+
+// defaultCategories are the categories that were hardcoded before the taxonomy became configurable
+var defaultCategories = []models.Category{
+	{Name: "DeFi", Slug: "defi"},
+	{Name: "Gaming", Slug: "gaming"},
+	{Name: "AI", Slug: "ai"},
+	{Name: "Infrastructure", Slug: "infrastructure"},
+	{Name: "Consumer", Slug: "consumer"},
+	{Name: "NFT", Slug: "nft", Aliases: []string{"NFTs"}},
+	{Name: "Stablecoins", Slug: "stablecoins", Aliases: []string{"Stablecoin"}},
+}
+
+// migrateCategories seeds the category taxonomy and rewrites aliases stored on projects and submissions, in any case,
+// to the category name. Later taxonomy edits rewrite the aliases they add themselves
+func migrateCategories(db *gorm.DB) error {
+	var count int64
+	if err := db.Model(&models.Category{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		for i, category := range defaultCategories {
+			category.DisplayOrder = i
+			if err := db.Create(&category).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	var categories []models.Category
+	if err := db.Find(&categories).Error; err != nil {
+		return err
+	}
+	for _, category := range categories {
+		for _, label := range append([]string{category.Name}, category.Aliases...) {
+			for _, table := range []string{"projects", "submissions"} {
+				err := db.Exec(`
+					UPDATE `+table+` SET categories = ARRAY(
+						SELECT label FROM (
+							SELECT CASE WHEN lower(c) = lower(?) THEN ? ELSE c END AS label, MIN(position) AS position
+							FROM unnest(categories) WITH ORDINALITY AS u(c, position)
+							GROUP BY 1
+						) renamed
+						ORDER BY position
+					)
+					WHERE EXISTS (SELECT 1 FROM unnest(categories) AS c WHERE lower(c) = lower(?) AND c <> ?)`,
+					label, category.Name, label, category.Name).Error
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// reconcileCounters recomputes the likes and comment counters of every project, which were not maintained
+// before per-voter likes and comments existed
+func reconcileCounters(db *gorm.DB) error {
+	err := db.Exec(`
+		UPDATE projects SET likes = counted.total
+		FROM (
+			SELECT projects.id, projects.legacy_likes + COUNT(project_likes.id) AS total
+			FROM projects
+			LEFT JOIN project_likes ON project_likes.project_id = projects.id
+			GROUP BY projects.id
+		) counted
+		WHERE projects.id = counted.id AND projects.likes IS DISTINCT FROM counted.total`).Error
+	if err != nil {
+		return err
+	}
+
+	return db.Exec(`
+		UPDATE projects SET comments = counted.total
+		FROM (
+			SELECT projects.id, COUNT(comments.id) AS total
+			FROM projects
+			LEFT JOIN comments ON comments.project_id = projects.id AND comments.status = 'visible'
+			GROUP BY projects.id
+		) counted
+		WHERE projects.id = counted.id AND projects.comments IS DISTINCT FROM counted.total`).Error
+}
+
+// linkBuilders links team members created before builders existed to the builder with their twitter handle,
+// creating builders on first use
+func linkBuilders(db *gorm.DB) error {
+	var batch []models.TeamMember
+	return db.Where("builder_id IS NULL AND twitter <> ''").
+		FindInBatches(&batch, 500, func(tx *gorm.DB, _ int) error {
+			for _, member := range batch {
+				handle := utils.NormalizeTwitterHandle(member.Twitter)
+				if handle == "" {
+					continue
+				}
+
+				var builder models.Builder
+				err := db.Where("handle = ? OR ? = ANY(aliases)", handle, handle).
+					Order(clause.Expr{SQL: "handle = ? DESC", Vars: []interface{}{handle}}).
+					First(&builder).Error
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					builder = models.Builder{Handle: handle, Name: member.Name, Image: member.Image}
+					err = db.Create(&builder).Error
+				}
+				if err != nil {
+					return err
+				}
+
+				if err := db.Model(&member).UpdateColumn("builder_id", builder.ID).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
+}
+
+// migrateAwards turns the award string that projects used to carry into award grants and drops the column
+func migrateAwards(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&models.Project{}, "award") {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		// One award per name and event; events were linked by migrateEvents
+		err := tx.Exec(`
+			INSERT INTO awards (name, event_id, track, created_at, updated_at)
+			SELECT DISTINCT ON (award, event_id) award, event_id, '', NOW(), NOW()
+			FROM projects
+			WHERE award IS NOT NULL AND award <> ''
+			ON CONFLICT DO NOTHING`).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`
+			INSERT INTO project_awards (project_id, award_id, granted_at)
+			SELECT projects.id, awards.id, projects.updated_at
+			FROM projects
+			JOIN awards ON awards.name = projects.award
+				AND awards.track = ''
+				AND awards.event_id IS NOT DISTINCT FROM projects.event_id
+			WHERE projects.award IS NOT NULL AND projects.award <> ''
+			ON CONFLICT DO NOTHING`).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Migrator().DropColumn(&models.Project{}, "award")
+	})
+}
@@ -0,0 +1,36 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// CSVWriter streams rows as RFC 4180 CSV
+type CSVWriter struct {
+	writer *csv.Writer
+}
+
+// NewCSVWriter creates a CSV row writer that streams to w
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{writer: csv.NewWriter(w)}
+}
+
+// WriteRow writes a single row
+func (cw *CSVWriter) WriteRow(cells []string) error {
+	sanitized := make([]string, len(cells))
+	for i, cell := range cells {
+		sanitized[i] = sanitizeCell(cell)
+	}
+	return cw.writer.Write(sanitized)
+}
+
+// Flush writes any buffered rows to the underlying writer
+func (cw *CSVWriter) Flush() error {
+	cw.writer.Flush()
+	return cw.writer.Error()
+}
+
+// Close flushes the remaining rows
+func (cw *CSVWriter) Close() error {
+	return cw.Flush()
+}
@@ -0,0 +1,68 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// RowWriter writes tabular data row by row without buffering the whole sheet
+type RowWriter interface {
+	WriteRow(cells []string) error
+	Flush() error
+	Close() error
+}
+
+// Format describes a supported export format
+type Format struct {
+	Name        string
+	ContentType string
+	Extension   string
+}
+
+var (
+	FormatCSV = Format{
+		Name:        "csv",
+		ContentType: "text/csv; charset=utf-8",
+		Extension:   "csv",
+	}
+	FormatXLSX = Format{
+		Name:        "xlsx",
+		ContentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		Extension:   "xlsx",
+	}
+)
+
+// ParseFormat resolves a format name, defaulting to CSV
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(name) {
+	case "", "csv":
+		return FormatCSV, nil
+	case "xlsx":
+		return FormatXLSX, nil
+	default:
+		return Format{}, fmt.Errorf("unsupported export format: %s", name)
+	}
+}
+
+// NewRowWriter creates a row writer for the format that streams to w
+func NewRowWriter(format Format, w io.Writer, sheetName string) (RowWriter, error) {
+	switch format.Name {
+	case FormatXLSX.Name:
+		return NewXLSXWriter(w, sheetName)
+	default:
+		return NewCSVWriter(w), nil
+	}
+}
+
+// sanitizeCell neutralizes values a spreadsheet would otherwise evaluate as formulas
+func sanitizeCell(value string) string {
+	if value == "" {
+		return value
+	}
+	switch value[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		return "'" + value
+	}
+	return value
+}
@@ -41,15 +41,7 @@ func (h *AnalyticsHandler) GetTransactions(c *gin.Context) {
 	var req services.GetTransactionsRequest
 
 	// Bind query parameters
-	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"success": false,
-			"error": gin.H{
-				"code":    "BAD_REQUEST",
-				"message": "Invalid query parameters",
-				"details": err.Error(),
-			},
-		})
+	if !bindQuery(c, &req) {
 		return
 	}
 
@@ -75,15 +67,7 @@ func (h *AnalyticsHandler) GetTopContracts(c *gin.Context) {
 	var req services.GetTopContractsRequest
 
 	// Bind query parameters
-	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"success": false,
-			"error": gin.H{
-				"code":    "BAD_REQUEST",
-				"message": "Invalid query parameters",
-				"details": err.Error(),
-			},
-		})
+	if !bindQuery(c, &req) {
 		return
 	}
 
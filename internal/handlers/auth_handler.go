@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"monad-devhub-be/internal/middleware"
 	"monad-devhub-be/internal/models"
 
 	"github.com/gin-gonic/gin"
@@ -48,6 +49,7 @@ type CreateAdminRequest struct {
 type Claims struct {
 	Role     string `json:"role"`
 	Username string `json:"username"`
+	UserID   uint   `json:"uid,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -98,7 +100,7 @@ func (h *AuthHandler) parseCredentials(input string) (username, password string,
 }
 
 // authenticateUser validates username/password against database with fallback to env
-func (h *AuthHandler) authenticateUser(username, password string) (bool, string) {
+func (h *AuthHandler) authenticateUser(username, password string) (bool, *models.AdminUser) {
 	var adminUser models.AdminUser
 	err := h.db.Where("username = ? AND is_active = ?", username, true).First(&adminUser).Error
 
@@ -106,9 +108,9 @@ func (h *AuthHandler) authenticateUser(username, password string) (bool, string)
 		// User found in database - check hashed password
 		err = bcrypt.CompareHashAndPassword([]byte(adminUser.Password), []byte(password))
 		if err == nil {
-			return true, username
+			return true, &adminUser
 		}
-		return false, ""
+		return false, nil
 	}
 
 	// Fallback to environment variable for backward compatibility
@@ -119,24 +121,16 @@ func (h *AuthHandler) authenticateUser(username, password string) (bool, string)
 
 	// Check if it matches the legacy format (admin-password)
 	if username == "admin" && password == envPassword {
-		return true, "admin"
+		return true, &models.AdminUser{Username: "admin", Role: "admin"}
 	}
 
-	return false, ""
+	return false, nil
 }
 
 // Login handles POST /api/v1/auth/login
 func (h *AuthHandler) Login(c *gin.Context) {
 	var req LoginRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"success": false,
-			"error": gin.H{
-				"code":    "INVALID_REQUEST",
-				"message": "Invalid request data",
-				"details": err.Error(),
-			},
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
@@ -154,7 +148,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	// Authenticate user
-	authenticated, authenticatedUsername := h.authenticateUser(username, password)
+	authenticated, authenticatedUser := h.authenticateUser(username, password)
 	if !authenticated {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"success": false,
@@ -167,7 +161,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	// Generate JWT token
-	token, err := h.generateJWT(authenticatedUsername)
+	token, err := h.generateJWT(authenticatedUser)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
@@ -189,15 +183,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 // CreateAdmin handles POST /api/v1/auth/admin (protected endpoint)
 func (h *AuthHandler) CreateAdmin(c *gin.Context) {
 	var req CreateAdminRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"success": false,
-			"error": gin.H{
-				"code":    "INVALID_REQUEST",
-				"message": "Invalid request data",
-				"details": err.Error(),
-			},
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
@@ -256,15 +242,7 @@ func (h *AuthHandler) CreateAdmin(c *gin.Context) {
 // ChangePassword handles PUT /api/v1/auth/change-password (protected endpoint)
 func (h *AuthHandler) ChangePassword(c *gin.Context) {
 	var req ChangePasswordRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"success": false,
-			"error": gin.H{
-				"code":    "INVALID_REQUEST",
-				"message": "Invalid request data",
-				"details": err.Error(),
-			},
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
@@ -295,7 +273,7 @@ func (h *AuthHandler) ChangePassword(c *gin.Context) {
 	}
 
 	// Verify current credentials
-	authenticated, _ := h.authenticateUser(currentUsername, currentPassword)
+	authenticated, currentUser := h.authenticateUser(currentUsername, currentPassword)
 	if !authenticated {
 		c.JSON(http.StatusUnauthorized, gin.H{
 			"success": false,
@@ -325,10 +303,11 @@ func (h *AuthHandler) ChangePassword(c *gin.Context) {
 	err = h.db.Where("username = ?", newUsername).First(&adminUser).Error
 
 	if err != nil {
-		// Create new admin user
+		// Create new user with the same role, so judges cannot mint admin accounts
 		adminUser = models.AdminUser{
 			Username: newUsername,
 			Password: string(hashedPassword),
+			Role:     currentUser.Role,
 			IsActive: true,
 		}
 		if err := h.db.Create(&adminUser).Error; err != nil {
@@ -342,6 +321,18 @@ func (h *AuthHandler) ChangePassword(c *gin.Context) {
 			return
 		}
 	} else {
+		// Only the account's own credentials (or an admin) may overwrite an existing user
+		if adminUser.Username != currentUser.Username && currentUser.Role != "admin" {
+			c.JSON(http.StatusForbidden, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "FORBIDDEN",
+					"message": "You can only change your own credentials",
+				},
+			})
+			return
+		}
+
 		// Update existing admin user
 		adminUser.Password = string(hashedPassword)
 		if err := h.db.Save(&adminUser).Error; err != nil {
@@ -363,6 +354,7 @@ func (h *AuthHandler) ChangePassword(c *gin.Context) {
 }
 
 // VerifyToken handles GET /api/v1/auth/verify
+// Only admin tokens are reported valid
 func (h *AuthHandler) VerifyToken(c *gin.Context) {
 	// Get token from Authorization header
 	authHeader := c.GetHeader("Authorization")
@@ -400,49 +392,32 @@ func (h *AuthHandler) VerifyToken(c *gin.Context) {
 	}
 }
 
-// generateJWT creates a new JWT token with 24h expiration
-func (h *AuthHandler) generateJWT(username string) (string, error) {
-	// Get JWT secret from environment
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		jwtSecret = "your-super-secret-jwt-key" // Default for development
-	}
-
+// generateJWT creates a new JWT token with 24h expiration carrying the user's role
+func (h *AuthHandler) generateJWT(user *models.AdminUser) (string, error) {
 	// Create claims
+	role := user.Role
+	if role == "" {
+		role = "admin"
+	}
 	claims := Claims{
-		Role:     "admin",
-		Username: username,
+		Role:     role,
+		Username: user.Username,
+		UserID:   user.ID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    "monad-devhub-api",
+			Audience:  jwt.ClaimStrings{middleware.AdminAudience},
 		},
 	}
 
 	// Create token
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(jwtSecret))
+	return token.SignedString([]byte(middleware.JWTSecret()))
 }
 
-// validateJWT validates a JWT token
+// validateJWT reports whether a token is a valid admin token; judge and builder tokens are rejected
 func (h *AuthHandler) validateJWT(tokenString string) bool {
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		jwtSecret = "your-super-secret-jwt-key"
-	}
-
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(jwtSecret), nil
-	})
-
-	if err != nil {
-		return false
-	}
-
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		// Check if token is expired
-		return claims.ExpiresAt.After(time.Now())
-	}
-
-	return false
+	claims, ok := middleware.ValidateAdminJWT(tokenString)
+	return ok && claims.Role == "admin"
 }
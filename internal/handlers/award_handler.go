@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AwardHandler struct {
+	awardService *services.AwardService
+}
+
+func NewAwardHandler(awardService *services.AwardService) *AwardHandler {
+	return &AwardHandler{
+		awardService: awardService,
+	}
+}
+
+// GetAwards handles GET /api/v1/awards
+// Returns all awards, optionally only those of one event (?event=name-or-slug)
+func (h *AwardHandler) GetAwards(c *gin.Context) {
+	awards, err := h.awardService.GetAwards(c.Query("event"))
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve awards")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"awards":  awards,
+	})
+}
+
+// CreateAward handles POST /api/v1/admin/awards
+// Admin-only endpoint to create an award
+func (h *AwardHandler) CreateAward(c *gin.Context) {
+	var req services.AwardRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	award, err := h.awardService.CreateAward(&req)
+	if err != nil {
+		h.respondError(c, err, "Failed to create award")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"award":   award,
+	})
+}
+
+// UpdateAward handles PUT /api/v1/admin/awards/:id
+// Admin-only endpoint to update an award
+func (h *AwardHandler) UpdateAward(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_AWARD_ID", "Invalid award ID format")
+	if !ok {
+		return
+	}
+
+	var req services.AwardRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	award, err := h.awardService.UpdateAward(id, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to update award")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"award":   award,
+	})
+}
+
+// DeleteAward handles DELETE /api/v1/admin/awards/:id
+// Admin-only endpoint to delete an award that has not been granted
+func (h *AwardHandler) DeleteAward(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_AWARD_ID", "Invalid award ID format")
+	if !ok {
+		return
+	}
+
+	if err := h.awardService.DeleteAward(id); err != nil {
+		h.respondError(c, err, "Failed to delete award")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Award deleted successfully",
+	})
+}
+
+// GrantAward handles POST /api/v1/admin/projects/:id/awards
+// Admin-only endpoint to grant an award to a project
+func (h *AwardHandler) GrantAward(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	var req services.GrantAwardRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	grant, err := h.awardService.GrantAward(projectID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to grant award")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success":      true,
+		"projectAward": grant,
+	})
+}
+
+// RevokeAward handles DELETE /api/v1/admin/projects/:id/awards/:awardId
+// Admin-only endpoint to revoke an award from a project
+func (h *AwardHandler) RevokeAward(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+	awardID, ok := parseUintParam(c, "awardId", "INVALID_AWARD_ID", "Invalid award ID format")
+	if !ok {
+		return
+	}
+
+	if err := h.awardService.RevokeAward(projectID, awardID); err != nil {
+		h.respondError(c, err, "Failed to revoke award")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Award revoked successfully",
+	})
+}
+
+// respondError maps award errors to HTTP responses
+func (h *AwardHandler) respondError(c *gin.Context, err error, message string) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "NOT_FOUND",
+				"message": "Award or project not found",
+			},
+		})
+		return
+	}
+
+	code, _, _ := strings.Cut(err.Error(), ":")
+	status := map[string]int{
+		"INVALID_AWARD":        http.StatusBadRequest,
+		"INVALID_EVENT":        http.StatusBadRequest,
+		"AWARD_EVENT_MISMATCH": http.StatusBadRequest,
+		"DUPLICATE_AWARD":      http.StatusConflict,
+		"ALREADY_GRANTED":      http.StatusConflict,
+		"AWARD_IN_USE":         http.StatusConflict,
+	}[code]
+	if status != 0 {
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": strings.TrimPrefix(err.Error(), code+": "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": message,
+			"details": err.Error(),
+		},
+	})
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/middleware"
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BuilderAuthHandler struct {
+	builderAuthService *services.BuilderAuthService
+}
+
+func NewBuilderAuthHandler(builderAuthService *services.BuilderAuthService) *BuilderAuthHandler {
+	return &BuilderAuthHandler{
+		builderAuthService: builderAuthService,
+	}
+}
+
+// GetNonce handles GET /api/v1/auth/siwe/nonce
+// Returns a single-use nonce to put in a Sign-In with Ethereum message
+func (h *BuilderAuthHandler) GetNonce(c *gin.Context) {
+	response, err := h.builderAuthService.IssueNonce()
+	if err != nil {
+		h.respondError(c, err, "Failed to issue nonce")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"nonce":     response.Nonce,
+		"expiresAt": response.ExpiresAt,
+	})
+}
+
+// SignIn handles POST /api/v1/auth/siwe/verify
+// Verifies a signed EIP-4361 message and returns a builder session token
+func (h *BuilderAuthHandler) SignIn(c *gin.Context) {
+	var req services.SignInRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	response, err := h.builderAuthService.SignIn(&req)
+	if err != nil {
+		h.respondError(c, err, "Failed to sign in")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"token":     response.Token,
+		"expiresAt": response.ExpiresAt,
+		"account":   response.Account,
+		"message":   "Login successful",
+	})
+}
+
+// GetMe handles GET /api/v1/auth/me
+// Builder-only endpoint returning the signed-in account and the projects it owns
+func (h *BuilderAuthHandler) GetMe(c *gin.Context) {
+	response, err := h.builderAuthService.GetMe(middleware.CurrentBuilderAddress(c))
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve account")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"account":  response.Account,
+		"projects": response.Projects,
+	})
+}
+
+// SetProjectOwner handles PUT /api/v1/admin/projects/:id/owner
+// Admin-only endpoint to assign a project to a builder's wallet address, or clear its owner
+func (h *BuilderAuthHandler) SetProjectOwner(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	var req services.SetProjectOwnerRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	if err := h.builderAuthService.SetProjectOwner(id, &req); err != nil {
+		h.respondError(c, err, "Failed to update project owner")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Project owner updated successfully",
+	})
+}
+
+// respondError maps sign-in errors to HTTP responses
+func (h *BuilderAuthHandler) respondError(c *gin.Context, err error, message string) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "NOT_FOUND",
+				"message": "Account or project not found",
+			},
+		})
+		return
+	}
+
+	code, _, _ := strings.Cut(err.Error(), ":")
+	status := map[string]int{
+		"INVALID_SIWE_MESSAGE": http.StatusBadRequest,
+		"INVALID_ADDRESS":      http.StatusBadRequest,
+		"SIWE_DOMAIN_MISMATCH": http.StatusUnauthorized,
+		"SIWE_CHAIN_MISMATCH":  http.StatusUnauthorized,
+		"SIWE_NOT_YET_VALID":   http.StatusUnauthorized,
+		"SIWE_EXPIRED":         http.StatusUnauthorized,
+		"INVALID_SIGNATURE":    http.StatusUnauthorized,
+		"INVALID_NONCE":        http.StatusUnauthorized,
+	}[code]
+	if status != 0 {
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": strings.TrimPrefix(err.Error(), code+": "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": message,
+			"details": err.Error(),
+		},
+	})
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type BuilderHandler struct {
+	builderService *services.BuilderService
+}
+
+func NewBuilderHandler(builderService *services.BuilderService) *BuilderHandler {
+	return &BuilderHandler{
+		builderService: builderService,
+	}
+}
+
+// GetBuilders handles GET /api/v1/builders
+// Returns the builder directory with project and award counts; supports search, page and limit
+func (h *BuilderHandler) GetBuilders(c *gin.Context) {
+	var req services.GetBuildersRequest
+	if !bindQuery(c, &req) {
+		return
+	}
+
+	response, err := h.builderService.GetBuilders(&req)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve builders")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    response,
+	})
+}
+
+// GetBuilder handles GET /api/v1/builders/:handle
+// Returns a builder's projects, awards and events
+func (h *BuilderHandler) GetBuilder(c *gin.Context) {
+	profile, err := h.builderService.GetBuilderProfile(c.Param("handle"))
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve builder")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    profile,
+	})
+}
+
+// MergeBuilders handles POST /api/v1/admin/builders/merge
+// Admin-only endpoint merging duplicate builders into one
+func (h *BuilderHandler) MergeBuilders(c *gin.Context) {
+	var req services.MergeBuildersRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	builder, err := h.builderService.MergeBuilders(&req)
+	if err != nil {
+		h.respondError(c, err, "Failed to merge builders")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"builder": builder,
+	})
+}
+
+// respondError maps builder errors to HTTP responses
+func (h *BuilderHandler) respondError(c *gin.Context, err error, message string) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "BUILDER_NOT_FOUND",
+				"message": "Builder not found",
+			},
+		})
+		return
+	}
+
+	if code, rest, ok := strings.Cut(err.Error(), ": "); ok && code == "INVALID_MERGE" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": rest,
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": message,
+			"details": err.Error(),
+		},
+	})
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CategoryHandler struct {
+	categoryService *services.CategoryService
+}
+
+func NewCategoryHandler(categoryService *services.CategoryService) *CategoryHandler {
+	return &CategoryHandler{
+		categoryService: categoryService,
+	}
+}
+
+// GetCategories handles GET /api/v1/categories
+// Returns the category hierarchy in display order
+func (h *CategoryHandler) GetCategories(c *gin.Context) {
+	tree, err := h.categoryService.GetCategoryTree()
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve categories")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"categories": tree,
+	})
+}
+
+// CreateCategory handles POST /api/v1/admin/categories
+// Admin-only endpoint to create a category
+func (h *CategoryHandler) CreateCategory(c *gin.Context) {
+	var req services.CategoryRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	category, err := h.categoryService.CreateCategory(&req)
+	if err != nil {
+		h.respondError(c, err, "Failed to create category")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success":  true,
+		"category": category,
+	})
+}
+
+// UpdateCategory handles PUT /api/v1/admin/categories/:id
+// Admin-only endpoint to update a category; renames are applied to stored projects and submissions
+func (h *CategoryHandler) UpdateCategory(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_CATEGORY_ID", "Invalid category ID format")
+	if !ok {
+		return
+	}
+
+	var req services.CategoryRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	category, err := h.categoryService.UpdateCategory(id, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to update category")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"category": category,
+	})
+}
+
+// DeleteCategory handles DELETE /api/v1/admin/categories/:id
+// Admin-only endpoint to delete an unused category
+func (h *CategoryHandler) DeleteCategory(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_CATEGORY_ID", "Invalid category ID format")
+	if !ok {
+		return
+	}
+
+	if err := h.categoryService.DeleteCategory(id); err != nil {
+		h.respondError(c, err, "Failed to delete category")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Category deleted successfully",
+	})
+}
+
+// respondError maps category errors to HTTP responses
+func (h *CategoryHandler) respondError(c *gin.Context, err error, message string) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "CATEGORY_NOT_FOUND",
+				"message": "Category not found",
+			},
+		})
+		return
+	}
+
+	code, _, _ := strings.Cut(err.Error(), ":")
+	status := map[string]int{
+		"INVALID_CATEGORY":   http.StatusBadRequest,
+		"DUPLICATE_CATEGORY": http.StatusConflict,
+		"CATEGORY_IN_USE":    http.StatusConflict,
+	}[code]
+	if status != 0 {
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": strings.TrimPrefix(err.Error(), code+": "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": message,
+			"details": err.Error(),
+		},
+	})
+}
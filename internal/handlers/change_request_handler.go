@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/middleware"
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ChangeRequestHandler struct {
+	changeRequestService *services.ChangeRequestService
+}
+
+func NewChangeRequestHandler(changeRequestService *services.ChangeRequestService) *ChangeRequestHandler {
+	return &ChangeRequestHandler{
+		changeRequestService: changeRequestService,
+	}
+}
+
+// ProposeChanges handles POST /api/v1/projects/:id/change-requests
+// Builder-only endpoint for a project's owner to propose edits
+func (h *ChangeRequestHandler) ProposeChanges(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	var req services.ProposeChangesRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	response, err := h.changeRequestService.ProposeChanges(projectID, middleware.CurrentBuilderAddress(c), &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to propose changes")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"applied": response.Applied,
+		"pending": response.Pending,
+	})
+}
+
+// GetOwnerChangeRequests handles GET /api/v1/projects/:id/change-requests
+// Builder-only list of the owner's change requests for their project
+func (h *ChangeRequestHandler) GetOwnerChangeRequests(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	requests, err := h.changeRequestService.GetOwnerChangeRequests(projectID, middleware.CurrentBuilderAddress(c))
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve change requests")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":        true,
+		"changeRequests": requests,
+	})
+}
+
+// WithdrawChangeRequest handles POST /api/v1/projects/:id/change-requests/:requestId/withdraw
+// Builder-only endpoint to take back a pending change request
+func (h *ChangeRequestHandler) WithdrawChangeRequest(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+	requestID, ok := parseUintParam(c, "requestId", "INVALID_CHANGE_REQUEST_ID", "Invalid change request ID format")
+	if !ok {
+		return
+	}
+
+	request, err := h.changeRequestService.WithdrawChangeRequest(projectID, requestID, middleware.CurrentBuilderAddress(c))
+	if err != nil {
+		h.respondError(c, err, "Failed to withdraw change request")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":       true,
+		"changeRequest": request,
+	})
+}
+
+// GetChangeRequests handles GET /api/v1/admin/change-requests
+// Admin-only review queue (?status=pending&projectId=)
+func (h *ChangeRequestHandler) GetChangeRequests(c *gin.Context) {
+	var req services.GetChangeRequestsRequest
+	if !bindQuery(c, &req) {
+		return
+	}
+
+	response, err := h.changeRequestService.GetChangeRequests(&req)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve change requests")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":        true,
+		"changeRequests": response.ChangeRequests,
+		"pagination":     response.Pagination,
+	})
+}
+
+// ApproveChangeRequest handles POST /api/v1/admin/change-requests/:id/approve
+// Admin-only endpoint to apply a change request to its project
+func (h *ChangeRequestHandler) ApproveChangeRequest(c *gin.Context) {
+	h.review(c, true)
+}
+
+// RejectChangeRequest handles POST /api/v1/admin/change-requests/:id/reject
+// Admin-only endpoint to reject a change request with a reason
+func (h *ChangeRequestHandler) RejectChangeRequest(c *gin.Context) {
+	h.review(c, false)
+}
+
+func (h *ChangeRequestHandler) review(c *gin.Context, approve bool) {
+	id, ok := parseUintParam(c, "id", "INVALID_CHANGE_REQUEST_ID", "Invalid change request ID format")
+	if !ok {
+		return
+	}
+
+	var req services.ReviewChangeRequestRequest
+	if c.Request.ContentLength != 0 && !bindJSON(c, &req) {
+		return
+	}
+
+	var err error
+	var response interface{}
+	if approve {
+		response, err = h.changeRequestService.ApproveChangeRequest(id, middleware.CurrentUserID(c), &req)
+	} else {
+		response, err = h.changeRequestService.RejectChangeRequest(id, middleware.CurrentUserID(c), &req)
+	}
+	if err != nil {
+		h.respondError(c, err, "Failed to review change request")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":       true,
+		"changeRequest": response,
+	})
+}
+
+// GetChangeLog handles GET /api/v1/admin/projects/:id/changelog
+// Admin-only history of a project's applied changes
+func (h *ChangeRequestHandler) GetChangeLog(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	var req services.GetChangeLogRequest
+	if !bindQuery(c, &req) {
+		return
+	}
+
+	response, err := h.changeRequestService.GetChangeLog(projectID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve change log")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"changes":    response.Changes,
+		"pagination": response.Pagination,
+	})
+}
+
+// respondError maps change request errors to HTTP responses
+func (h *ChangeRequestHandler) respondError(c *gin.Context, err error, message string) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "NOT_FOUND",
+				"message": "Project or change request not found",
+			},
+		})
+		return
+	}
+
+	code, _, _ := strings.Cut(err.Error(), ":")
+	status := map[string]int{
+		"INVALID_CHANGE_REQUEST": http.StatusBadRequest,
+		"INVALID_TEAM_MEMBERS":   http.StatusBadRequest,
+		"INVALID_STATUS":         http.StatusBadRequest,
+		"FORBIDDEN":              http.StatusForbidden,
+		"CHANGE_PENDING":         http.StatusConflict,
+		"CHANGE_REQUEST_STALE":   http.StatusConflict,
+		"INVALID_REVIEW":         http.StatusConflict,
+	}[code]
+	if status != 0 {
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": strings.TrimPrefix(err.Error(), code+": "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": message,
+			"details": err.Error(),
+		},
+	})
+}
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type CollectionHandler struct {
+	collectionService *services.CollectionService
+}
+
+func NewCollectionHandler(collectionService *services.CollectionService) *CollectionHandler {
+	return &CollectionHandler{
+		collectionService: collectionService,
+	}
+}
+
+// GetCollections handles GET /api/v1/collections
+// Lists published collections with the number of published projects in each
+func (h *CollectionHandler) GetCollections(c *gin.Context) {
+	h.listCollections(c, false)
+}
+
+// GetAllCollections handles GET /api/v1/admin/collections
+// Admin-only endpoint listing every collection, including drafts
+func (h *CollectionHandler) GetAllCollections(c *gin.Context) {
+	h.listCollections(c, true)
+}
+
+func (h *CollectionHandler) listCollections(c *gin.Context, includeDrafts bool) {
+	collections, err := h.collectionService.GetCollections(includeDrafts)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve collections")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":     true,
+		"collections": collections,
+	})
+}
+
+// GetCollection handles GET /api/v1/collections/:slug
+// Returns a published collection with its published projects in curated order
+func (h *CollectionHandler) GetCollection(c *gin.Context) {
+	collection, err := h.collectionService.GetCollection(c.Param("slug"))
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve collection")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"collection": collection,
+	})
+}
+
+// GetCollectionForAdmin handles GET /api/v1/admin/collections/:id
+// Admin-only lookup of any collection with all of its projects
+func (h *CollectionHandler) GetCollectionForAdmin(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_COLLECTION_ID", "Invalid collection ID format")
+	if !ok {
+		return
+	}
+
+	collection, err := h.collectionService.GetCollectionForAdmin(id)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve collection")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"collection": collection,
+	})
+}
+
+// CreateCollection handles POST /api/v1/admin/collections
+// Admin-only endpoint to create a collection
+func (h *CollectionHandler) CreateCollection(c *gin.Context) {
+	var req services.CollectionRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	collection, err := h.collectionService.CreateCollection(&req)
+	if err != nil {
+		h.respondError(c, err, "Failed to create collection")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success":    true,
+		"collection": collection,
+	})
+}
+
+// UpdateCollection handles PUT /api/v1/admin/collections/:id
+// Admin-only endpoint to replace a collection's details and, optionally, its projects
+func (h *CollectionHandler) UpdateCollection(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_COLLECTION_ID", "Invalid collection ID format")
+	if !ok {
+		return
+	}
+
+	var req services.CollectionRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	collection, err := h.collectionService.UpdateCollection(id, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to update collection")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"collection": collection,
+	})
+}
+
+// DeleteCollection handles DELETE /api/v1/admin/collections/:id
+// Admin-only endpoint to delete a collection
+func (h *CollectionHandler) DeleteCollection(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_COLLECTION_ID", "Invalid collection ID format")
+	if !ok {
+		return
+	}
+
+	if err := h.collectionService.DeleteCollection(id); err != nil {
+		h.respondError(c, err, "Failed to delete collection")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Collection deleted successfully",
+	})
+}
+
+// respondError maps collection errors to HTTP responses
+func (h *CollectionHandler) respondError(c *gin.Context, err error, message string) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "COLLECTION_NOT_FOUND",
+				"message": "Collection not found",
+			},
+		})
+		return
+	}
+
+	code, _, _ := strings.Cut(err.Error(), ":")
+	status := map[string]int{
+		"INVALID_COLLECTION":   http.StatusBadRequest,
+		"DUPLICATE_COLLECTION": http.StatusConflict,
+	}[code]
+	if status != 0 {
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": strings.TrimPrefix(err.Error(), code+": "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": message,
+			"details": err.Error(),
+		},
+	})
+}
@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/middleware"
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// commentTokenHeader carries the token that identifies a comment author
+const commentTokenHeader = "X-Comment-Token"
+
+type CommentHandler struct {
+	commentService *services.CommentService
+}
+
+func NewCommentHandler(commentService *services.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+	}
+}
+
+// GetComments handles GET /api/v1/projects/:id/comments
+// Returns a page of top-level comments with their replies nested below them
+func (h *CommentHandler) GetComments(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	var req services.GetCommentsRequest
+	if !bindQuery(c, &req) {
+		return
+	}
+
+	response, err := h.commentService.GetComments(projectID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve comments")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"comments":   response.Comments,
+		"pagination": response.Pagination,
+	})
+}
+
+// CreateComment handles POST /api/v1/projects/:id/comments
+// Posts a comment or reply; signed-in builders post as their account, and first-time anonymous
+// authors receive a token to send as X-Comment-Token
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	var req services.CreateCommentRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	response, err := h.commentService.CreateComment(projectID, &req, h.commentAuthor(c))
+	if err != nil {
+		h.respondError(c, err, "Failed to post comment")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success":     true,
+		"comment":     response.Comment,
+		"authorToken": response.AuthorToken,
+	})
+}
+
+// DeleteComment handles DELETE /api/v1/projects/:id/comments/:commentId
+// Authors delete their own comments with their X-Comment-Token or builder session; admins may delete any comment
+func (h *CommentHandler) DeleteComment(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+	commentID, ok := parseUintParam(c, "commentId", "INVALID_COMMENT_ID", "Invalid comment ID format")
+	if !ok {
+		return
+	}
+
+	err := h.commentService.DeleteComment(projectID, commentID, h.commentAuthor(c), middleware.IsAdmin(c))
+	if err != nil {
+		h.respondError(c, err, "Failed to delete comment")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Comment deleted successfully",
+	})
+}
+
+// GetModerationComments handles GET /api/v1/admin/comments
+// Admin-only list of comments of every status (?status=visible|hidden|deleted&projectId=)
+func (h *CommentHandler) GetModerationComments(c *gin.Context) {
+	var req services.GetModerationCommentsRequest
+	if !bindQuery(c, &req) {
+		return
+	}
+
+	response, err := h.commentService.GetModerationComments(&req)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve comments")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"comments":   response.Comments,
+		"pagination": response.Pagination,
+	})
+}
+
+// HideComment handles POST /api/v1/admin/comments/:id/hide
+// Admin-only endpoint to hide a comment with an optional reason
+func (h *CommentHandler) HideComment(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_COMMENT_ID", "Invalid comment ID format")
+	if !ok {
+		return
+	}
+
+	var req services.HideCommentRequest
+	if c.Request.ContentLength != 0 && !bindJSON(c, &req) {
+		return
+	}
+
+	comment, err := h.commentService.HideComment(id, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to hide comment")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"comment": comment,
+	})
+}
+
+// UnhideComment handles POST /api/v1/admin/comments/:id/unhide
+// Admin-only endpoint to restore a hidden comment
+func (h *CommentHandler) UnhideComment(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_COMMENT_ID", "Invalid comment ID format")
+	if !ok {
+		return
+	}
+
+	comment, err := h.commentService.UnhideComment(id)
+	if err != nil {
+		h.respondError(c, err, "Failed to restore comment")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"comment": comment,
+	})
+}
+
+// PinComment handles POST /api/v1/admin/comments/:id/pin
+// Admin-only endpoint to pin a top-level comment
+func (h *CommentHandler) PinComment(c *gin.Context) {
+	h.setPinned(c, true)
+}
+
+// UnpinComment handles POST /api/v1/admin/comments/:id/unpin
+// Admin-only endpoint to unpin a comment
+func (h *CommentHandler) UnpinComment(c *gin.Context) {
+	h.setPinned(c, false)
+}
+
+func (h *CommentHandler) setPinned(c *gin.Context, pinned bool) {
+	id, ok := parseUintParam(c, "id", "INVALID_COMMENT_ID", "Invalid comment ID format")
+	if !ok {
+		return
+	}
+
+	comment, err := h.commentService.PinComment(id, pinned)
+	if err != nil {
+		h.respondError(c, err, "Failed to update comment")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"comment": comment,
+	})
+}
+
+// BanAuthor handles POST /api/v1/admin/comments/:id/ban
+// Admin-only endpoint to ban the author of a comment
+func (h *CommentHandler) BanAuthor(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_COMMENT_ID", "Invalid comment ID format")
+	if !ok {
+		return
+	}
+
+	var req services.BanAuthorRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	response, err := h.commentService.BanAuthor(id, middleware.CurrentUserID(c), &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to ban author")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success":        true,
+		"ban":            response.Ban,
+		"hiddenComments": response.HiddenComments,
+	})
+}
+
+// GetBans handles GET /api/v1/admin/comment-bans
+// Admin-only list of bans in effect
+func (h *CommentHandler) GetBans(c *gin.Context) {
+	bans, err := h.commentService.GetBans()
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve bans")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"bans":    bans,
+	})
+}
+
+// LiftBan handles DELETE /api/v1/admin/comment-bans/:id
+// Admin-only endpoint to lift a ban
+func (h *CommentHandler) LiftBan(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_BAN_ID", "Invalid ban ID format")
+	if !ok {
+		return
+	}
+
+	if err := h.commentService.LiftBan(id); err != nil {
+		h.respondError(c, err, "Failed to lift ban")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Ban lifted successfully",
+	})
+}
+
+// ReconcileCommentCounts handles POST /api/v1/admin/comments/reconcile
+// Admin-only endpoint to recompute every project's comment counter
+func (h *CommentHandler) ReconcileCommentCounts(c *gin.Context) {
+	corrected, err := h.commentService.ReconcileCommentCounts()
+	if err != nil {
+		h.respondError(c, err, "Failed to reconcile comment counts")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"corrected": corrected,
+	})
+}
+
+// commentAuthor collects what the request reveals about who is commenting
+func (h *CommentHandler) commentAuthor(c *gin.Context) services.CommentAuthor {
+	return services.CommentAuthor{
+		BuilderAddress: middleware.CurrentBuilderAddress(c),
+		Token:          c.GetHeader(commentTokenHeader),
+		IP:             c.ClientIP(),
+	}
+}
+
+// respondError maps comment errors to HTTP responses
+func (h *CommentHandler) respondError(c *gin.Context, err error, message string) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "NOT_FOUND",
+				"message": "Project or comment not found",
+			},
+		})
+		return
+	}
+
+	code, _, _ := strings.Cut(err.Error(), ":")
+	status := map[string]int{
+		"INVALID_COMMENT":       http.StatusBadRequest,
+		"INVALID_PARENT":        http.StatusBadRequest,
+		"INVALID_COMMENT_TOKEN": http.StatusBadRequest,
+		"COMMENT_REJECTED":      http.StatusUnprocessableEntity,
+		"COMMENT_BANNED":        http.StatusForbidden,
+		"FORBIDDEN":             http.StatusForbidden,
+		"RATE_LIMITED":          http.StatusTooManyRequests,
+		"INVALID_MODERATION":    http.StatusConflict,
+	}[code]
+	if status != 0 {
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": strings.TrimPrefix(err.Error(), code+": "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": message,
+			"details": err.Error(),
+		},
+	})
+}
@@ -0,0 +1,242 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/middleware"
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ContractHandler struct {
+	contractService *services.ContractService
+}
+
+func NewContractHandler(contractService *services.ContractService) *ContractHandler {
+	return &ContractHandler{
+		contractService: contractService,
+	}
+}
+
+// GetProjectOnchain handles GET /api/v1/projects/:id/onchain
+// Returns transaction counts, unique wallets and gas used by the project's verified contracts over time
+func (h *ContractHandler) GetProjectOnchain(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	var req services.GetOnchainRequest
+	if !bindQuery(c, &req) {
+		return
+	}
+
+	response, err := h.contractService.GetProjectOnchain(projectID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve on-chain activity")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"onchain": response,
+	})
+}
+
+// GetContractDeclarations handles GET /api/v1/admin/contracts
+// Admin-only review queue of declared contracts (?status=pending|verified|rejected)
+func (h *ContractHandler) GetContractDeclarations(c *gin.Context) {
+	status := c.Query("status")
+	if status != "" && status != "pending" && status != "verified" && status != "rejected" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "BAD_REQUEST",
+				"message": "status must be pending, verified or rejected",
+			},
+		})
+		return
+	}
+
+	contracts, err := h.contractService.GetContractDeclarations(status)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve contracts")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"contracts": contracts,
+	})
+}
+
+// GetProjectContracts handles GET /api/v1/admin/projects/:id/contracts
+// Admin-only endpoint listing every contract a project declared
+func (h *ContractHandler) GetProjectContracts(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	contracts, err := h.contractService.GetProjectContracts(projectID)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve contracts")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"contracts": contracts,
+	})
+}
+
+// AddProjectContract handles POST /api/v1/admin/projects/:id/contracts
+// Admin-only endpoint to add a verified contract to a project
+func (h *ContractHandler) AddProjectContract(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	var req services.DeclareContractRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	contract, err := h.contractService.AddProjectContract(projectID, middleware.CurrentUserID(c), &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to add contract")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success":  true,
+		"contract": contract,
+	})
+}
+
+// VerifyContract handles POST /api/v1/admin/projects/:id/contracts/:contractId/verify
+// Admin-only endpoint to confirm a declared contract and link it to its analytics contract
+func (h *ContractHandler) VerifyContract(c *gin.Context) {
+	projectID, declarationID, ok := h.parseIDs(c)
+	if !ok {
+		return
+	}
+
+	var req services.VerifyContractRequest
+	if c.Request.ContentLength != 0 && !bindJSON(c, &req) {
+		return
+	}
+
+	contract, err := h.contractService.VerifyContract(projectID, declarationID, middleware.CurrentUserID(c), &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to verify contract")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"contract": contract,
+	})
+}
+
+// RejectContract handles POST /api/v1/admin/projects/:id/contracts/:contractId/reject
+// Admin-only endpoint to reject a declared contract with a reason
+func (h *ContractHandler) RejectContract(c *gin.Context) {
+	projectID, declarationID, ok := h.parseIDs(c)
+	if !ok {
+		return
+	}
+
+	var req services.RejectContractRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	contract, err := h.contractService.RejectContract(projectID, declarationID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to reject contract")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"contract": contract,
+	})
+}
+
+// DeleteProjectContract handles DELETE /api/v1/admin/projects/:id/contracts/:contractId
+// Admin-only endpoint to remove a contract declaration
+func (h *ContractHandler) DeleteProjectContract(c *gin.Context) {
+	projectID, declarationID, ok := h.parseIDs(c)
+	if !ok {
+		return
+	}
+
+	if err := h.contractService.DeleteProjectContract(projectID, declarationID); err != nil {
+		h.respondError(c, err, "Failed to delete contract")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Contract removed successfully",
+	})
+}
+
+// parseIDs parses the project and contract declaration ID path parameters
+func (h *ContractHandler) parseIDs(c *gin.Context) (uint, uint, bool) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return 0, 0, false
+	}
+	declarationID, ok := parseUintParam(c, "contractId", "INVALID_CONTRACT_ID", "Invalid contract ID format")
+	if !ok {
+		return 0, 0, false
+	}
+	return projectID, declarationID, true
+}
+
+// respondError maps contract errors to HTTP responses
+func (h *ContractHandler) respondError(c *gin.Context, err error, message string) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "NOT_FOUND",
+				"message": "Project or contract not found",
+			},
+		})
+		return
+	}
+
+	code, _, _ := strings.Cut(err.Error(), ":")
+	status := map[string]int{
+		"INVALID_CONTRACT":         http.StatusBadRequest,
+		"INVALID_CONTRACT_ADDRESS": http.StatusBadRequest,
+		"INVALID_INTERVAL":         http.StatusBadRequest,
+		"DUPLICATE_CONTRACT":       http.StatusConflict,
+		"ALREADY_VERIFIED":         http.StatusConflict,
+	}[code]
+	if status != 0 {
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": strings.TrimPrefix(err.Error(), code+": "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": message,
+			"details": err.Error(),
+		},
+	})
+}
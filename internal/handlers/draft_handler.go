@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/services"
+	"monad-devhub-be/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DraftHandler struct {
+	draftService *services.DraftService
+}
+
+func NewDraftHandler(draftService *services.DraftService) *DraftHandler {
+	return &DraftHandler{
+		draftService: draftService,
+	}
+}
+
+// CreateDraft handles POST /api/v1/submissions/drafts
+// Saves partial submission data and returns a draft token
+func (h *DraftHandler) CreateDraft(c *gin.Context) {
+	var req services.SaveDraftRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INVALID_DRAFT_DATA",
+				"message": "Invalid draft data",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	response, err := h.draftService.CreateDraft(&req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to save draft",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// GetDraft handles GET /api/v1/submissions/drafts/:draftId
+func (h *DraftHandler) GetDraft(c *gin.Context) {
+	draftID := c.Param("draftId")
+	if !h.validateDraftID(c, draftID) {
+		return
+	}
+
+	response, err := h.draftService.GetDraft(draftID)
+	if err != nil {
+		h.respondDraftError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateDraft handles PUT /api/v1/submissions/drafts/:draftId
+func (h *DraftHandler) UpdateDraft(c *gin.Context) {
+	draftID := c.Param("draftId")
+	if !h.validateDraftID(c, draftID) {
+		return
+	}
+
+	var req services.SaveDraftRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INVALID_DRAFT_DATA",
+				"message": "Invalid draft data",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	response, err := h.draftService.UpdateDraft(draftID, &req)
+	if err != nil {
+		h.respondDraftError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// FinalizeDraft handles POST /api/v1/submissions/drafts/:draftId/finalize
+// Runs full submission validation and converts the draft into a submission
+func (h *DraftHandler) FinalizeDraft(c *gin.Context) {
+	draftID := c.Param("draftId")
+	if !h.validateDraftID(c, draftID) {
+		return
+	}
+
+	response, err := h.draftService.FinalizeDraft(draftID)
+	if err != nil {
+		if err.Error() == "record not found" || strings.HasPrefix(err.Error(), "DRAFT_ALREADY_FINALIZED") {
+			h.respondDraftError(c, err)
+			return
+		}
+
+		if strings.HasPrefix(err.Error(), "INCOMPLETE_DRAFT") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "VALIDATION_ERROR",
+					"message": "Draft is missing required submission fields",
+					"details": strings.TrimPrefix(err.Error(), "INCOMPLETE_DRAFT: "),
+				},
+			})
+			return
+		}
+
+		respondSubmissionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// validateDraftID checks the draft token format and writes an error response if invalid
+func (h *DraftHandler) validateDraftID(c *gin.Context, draftID string) bool {
+	if !utils.ValidateDraftToken(draftID) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INVALID_DRAFT_ID",
+				"message": "Invalid draft ID format",
+			},
+		})
+		return false
+	}
+	return true
+}
+
+// respondDraftError maps draft lookup and state errors to HTTP responses
+func (h *DraftHandler) respondDraftError(c *gin.Context, err error) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "DRAFT_NOT_FOUND",
+				"message": "Draft not found or expired",
+			},
+		})
+		return
+	}
+
+	if strings.HasPrefix(err.Error(), "DRAFT_ALREADY_FINALIZED") {
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "DRAFT_ALREADY_FINALIZED",
+				"message": strings.TrimPrefix(err.Error(), "DRAFT_ALREADY_FINALIZED: "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": "Failed to process draft",
+			"details": err.Error(),
+		},
+	})
+}
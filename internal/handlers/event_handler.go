@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EventHandler struct {
+	eventService *services.EventService
+}
+
+func NewEventHandler(eventService *services.EventService) *EventHandler {
+	return &EventHandler{
+		eventService: eventService,
+	}
+}
+
+// GetEvents handles GET /api/v1/events
+// Lists every event except drafts
+func (h *EventHandler) GetEvents(c *gin.Context) {
+	h.listEvents(c, false)
+}
+
+// GetAllEvents handles GET /api/v1/admin/events
+// Admin-only endpoint listing every event, including drafts
+func (h *EventHandler) GetAllEvents(c *gin.Context) {
+	h.listEvents(c, true)
+}
+
+func (h *EventHandler) listEvents(c *gin.Context, includeDrafts bool) {
+	events, err := h.eventService.GetEvents(includeDrafts)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to retrieve events",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"events":  events,
+	})
+}
+
+// GetEvent handles GET /api/v1/events/:id
+// Accepts a numeric ID or a slug
+func (h *EventHandler) GetEvent(c *gin.Context) {
+	event, err := h.eventService.GetEvent(c.Param("id"), false)
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve event")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"event":   event,
+	})
+}
+
+// GetFormSchema handles GET /api/v1/events/:id/form-schema
+// Returns the JSON Schema of the event's extra submission fields so clients can render the form
+func (h *EventHandler) GetFormSchema(c *gin.Context) {
+	schema, err := h.eventService.GetFormSchema(c.Param("id"))
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve form schema")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"form":    schema,
+	})
+}
+
+// CreateEvent handles POST /api/v1/admin/events
+// Admin-only endpoint to create an event
+func (h *EventHandler) CreateEvent(c *gin.Context) {
+	var req services.EventRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	event, err := h.eventService.CreateEvent(&req)
+	if err != nil {
+		h.respondError(c, err, "Failed to create event")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"event":   event,
+	})
+}
+
+// UpdateEvent handles PUT /api/v1/admin/events/:id
+// Admin-only endpoint to replace an event's details
+func (h *EventHandler) UpdateEvent(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_EVENT_ID", "Invalid event ID format")
+	if !ok {
+		return
+	}
+
+	var req services.EventRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	event, err := h.eventService.UpdateEvent(id, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to update event")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"event":   event,
+	})
+}
+
+// DeleteEvent handles DELETE /api/v1/admin/events/:id
+// Admin-only endpoint to delete an unused event
+func (h *EventHandler) DeleteEvent(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_EVENT_ID", "Invalid event ID format")
+	if !ok {
+		return
+	}
+
+	if err := h.eventService.DeleteEvent(id); err != nil {
+		h.respondError(c, err, "Failed to delete event")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Event deleted successfully",
+	})
+}
+
+// respondError maps event errors to HTTP responses
+func (h *EventHandler) respondError(c *gin.Context, err error, message string) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "EVENT_NOT_FOUND",
+				"message": "Event not found",
+			},
+		})
+		return
+	}
+
+	code, _, _ := strings.Cut(err.Error(), ":")
+	status := map[string]int{
+		"INVALID_EVENT":       http.StatusBadRequest,
+		"INVALID_FORM_SCHEMA": http.StatusBadRequest,
+		"DUPLICATE_EVENT":     http.StatusConflict,
+		"EVENT_IN_USE":        http.StatusConflict,
+	}[code]
+	if status != 0 {
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": strings.TrimPrefix(err.Error(), code+": "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": message,
+			"details": err.Error(),
+		},
+	})
+}
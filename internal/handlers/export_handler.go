@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"monad-devhub-be/internal/export"
+	"monad-devhub-be/internal/services"
+	"monad-devhub-be/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExportHandler struct {
+	exportService *services.ExportService
+}
+
+func NewExportHandler(exportService *services.ExportService) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+	}
+}
+
+// ExportSubmissions handles GET /api/v1/admin/export/submissions
+// Admin-only endpoint streaming submissions as CSV or XLSX; accepts the submission list filters
+func (h *ExportHandler) ExportSubmissions(c *gin.Context) {
+	format, ok := h.parseFormat(c)
+	if !ok {
+		return
+	}
+
+	req := &services.GetSubmissionsRequest{
+		Status:   c.Query("status"),
+		Event:    c.Query("event"),
+		Category: utils.RemoveEmpty(c.QueryArray("category")),
+		Search:   c.Query("search"),
+	}
+	if err := parseSubmissionFilters(c, req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "BAD_REQUEST",
+				"message": "Invalid query parameters",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	// Size the team member columns before streaming starts so errors can still be reported as JSON
+	teamSize, err := h.exportService.SubmissionExportTeamSize(req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "EXPORT_FAILED",
+				"message": "Failed to export submissions",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	writer, ok := h.startDownload(c, format, "submissions", "Submissions")
+	if !ok {
+		return
+	}
+	if err := h.exportService.ExportSubmissions(writer, req, teamSize); err != nil {
+		// Headers are already sent; the truncated download is the only signal left
+		log.Printf("Submissions export failed mid-stream: %v", err)
+		c.Abort()
+	}
+}
+
+// ExportProjects handles GET /api/v1/admin/export/projects
+// Admin-only endpoint streaming all projects as CSV or XLSX
+func (h *ExportHandler) ExportProjects(c *gin.Context) {
+	format, ok := h.parseFormat(c)
+	if !ok {
+		return
+	}
+
+	teamSize, err := h.exportService.ProjectExportTeamSize()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "EXPORT_FAILED",
+				"message": "Failed to export projects",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	writer, ok := h.startDownload(c, format, "projects", "Projects")
+	if !ok {
+		return
+	}
+	if err := h.exportService.ExportProjects(writer, teamSize); err != nil {
+		log.Printf("Projects export failed mid-stream: %v", err)
+		c.Abort()
+	}
+}
+
+// parseFormat reads the format query parameter and writes an error response if it is unsupported
+func (h *ExportHandler) parseFormat(c *gin.Context) (export.Format, bool) {
+	format, err := export.ParseFormat(c.DefaultQuery("format", "csv"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "UNSUPPORTED_FORMAT",
+				"message": "Supported export formats are csv and xlsx",
+				"details": err.Error(),
+			},
+		})
+		return export.Format{}, false
+	}
+	return format, true
+}
+
+// startDownload sends the attachment headers and returns a row writer streaming to the response
+func (h *ExportHandler) startDownload(c *gin.Context, format export.Format, baseName, sheetName string) (export.RowWriter, bool) {
+	filename := fmt.Sprintf("%s-%s.%s", baseName, time.Now().UTC().Format("20060102-150405"), format.Extension)
+	c.Header("Content-Type", format.ContentType)
+	c.Header("Content-Disposition", "attachment; filename="+strconv.Quote(filename))
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+
+	writer, err := export.NewRowWriter(format, c.Writer, sheetName)
+	if err != nil {
+		log.Printf("Failed to start %s export: %v", baseName, err)
+		c.Abort()
+		return nil, false
+	}
+	return writer, true
+}
@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bindJSON binds the request body, responding with 400 when it is invalid
+func bindJSON(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INVALID_REQUEST",
+				"message": "Invalid request data",
+				"details": err.Error(),
+			},
+		})
+		return false
+	}
+	return true
+}
+
+// bindQuery binds the query parameters, responding with 400 when they are invalid
+func bindQuery(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindQuery(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "BAD_REQUEST",
+				"message": "Invalid query parameters",
+				"details": err.Error(),
+			},
+		})
+		return false
+	}
+	return true
+}
+
+// parseUintParam parses a numeric path parameter, responding with 400 and the given error code when it is invalid
+func parseUintParam(c *gin.Context, param, code, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(param), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": message,
+			},
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"monad-devhub-be/internal/importer"
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxImportUploadBytes bounds the size of an uploaded import (file plus mapping)
+const maxImportUploadBytes = 20 << 20
+
+type ImportHandler struct {
+	importService *services.ImportService
+}
+
+func NewImportHandler(importService *services.ImportService) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+	}
+}
+
+// Import handles POST /api/v1/admin/import
+// Admin-only endpoint importing a CSV or JSON export from an external hackathon platform.
+// Multipart form fields: file, mapping (JSON config as a file or text), source, target, format, dryRun.
+func (h *ImportHandler) Import(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportUploadBytes)
+
+	req, err := h.parseImportRequest(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "BAD_REQUEST",
+				"message": "Invalid import request",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	report, err := h.importService.Import(req)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "INVALID_IMPORT") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "INVALID_IMPORT",
+					"message": strings.TrimPrefix(err.Error(), "INVALID_IMPORT: "),
+				},
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "IMPORT_FAILED",
+				"message": "Failed to import entries",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	// A real import that skipped rows reports partial success
+	status := http.StatusOK
+	if !report.DryRun && report.HasProblems() {
+		status = http.StatusMultiStatus
+	}
+	c.JSON(status, gin.H{
+		"success": true,
+		"data":    report,
+	})
+}
+
+// parseImportRequest reads the uploaded file and mapping config from the multipart form
+func (h *ImportHandler) parseImportRequest(c *gin.Context) (*services.ImportRequest, error) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return nil, err
+	}
+	format, err := importer.ParseFormat(c.PostForm("format"), fileHeader.Filename)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	records, err := importer.ReadRecords(format, file)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := h.readMapping(c)
+	if err != nil {
+		return nil, err
+	}
+
+	dryRun := false
+	if value := c.PostForm("dryRun"); value != "" {
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			return nil, err
+		}
+	}
+
+	target := c.PostForm("target")
+	if target == "" {
+		target = services.ImportTargetSubmissions
+	}
+
+	return &services.ImportRequest{
+		Source:  c.PostForm("source"),
+		Target:  target,
+		DryRun:  dryRun,
+		Mapping: mapping,
+		Records: records,
+	}, nil
+}
+
+// readMapping accepts the mapping config either as an uploaded file or as a form value
+func (h *ImportHandler) readMapping(c *gin.Context) (*importer.Mapping, error) {
+	if mappingHeader, err := c.FormFile("mapping"); err == nil {
+		mappingFile, err := mappingHeader.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer mappingFile.Close()
+		return importer.LoadMapping(mappingFile)
+	}
+
+	mappingJSON := c.PostForm("mapping")
+	if mappingJSON == "" {
+		return nil, errors.New("mapping config is required")
+	}
+	return importer.LoadMapping(strings.NewReader(mappingJSON))
+}
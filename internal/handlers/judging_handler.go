@@ -0,0 +1,239 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/middleware"
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JudgingHandler struct {
+	judgingService *services.JudgingService
+}
+
+func NewJudgingHandler(judgingService *services.JudgingService) *JudgingHandler {
+	return &JudgingHandler{
+		judgingService: judgingService,
+	}
+}
+
+// judgingErrorStatus maps judging error code prefixes to HTTP status codes
+var judgingErrorStatus = map[string]int{
+	"USERNAME_EXISTS":            http.StatusConflict,
+	"INVALID_EVENT":              http.StatusBadRequest,
+	"INVALID_CRITERION":          http.StatusBadRequest,
+	"INVALID_SCORE":              http.StatusBadRequest,
+	"RUBRIC_LOCKED":              http.StatusConflict,
+	"NOT_ASSIGNED":               http.StatusForbidden,
+	"NOT_ENOUGH_SCORED_PROJECTS": http.StatusConflict,
+}
+
+// CreateJudge handles POST /api/v1/admin/judges
+// Admin-only endpoint to create a judge account assigned to events
+func (h *JudgingHandler) CreateJudge(c *gin.Context) {
+	var req services.CreateJudgeRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	judge, err := h.judgingService.CreateJudge(&req)
+	if err != nil {
+		h.respondError(c, err, "Failed to create judge")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"judge":   judge,
+	})
+}
+
+// GetJudges handles GET /api/v1/admin/judges
+// Admin-only endpoint listing judge accounts and their event assignments
+func (h *JudgingHandler) GetJudges(c *gin.Context) {
+	judges, err := h.judgingService.GetJudges()
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve judges")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"judges":  judges,
+	})
+}
+
+// SetJudgeEvents handles PUT /api/v1/admin/judges/:judgeId/events
+// Admin-only endpoint replacing the events a judge is assigned to
+func (h *JudgingHandler) SetJudgeEvents(c *gin.Context) {
+	judgeID, ok := parseUintParam(c, "judgeId", "INVALID_JUDGE_ID", "Invalid ID format")
+	if !ok {
+		return
+	}
+
+	var req services.SetJudgeEventsRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	judge, err := h.judgingService.SetJudgeEvents(judgeID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to update judge assignments")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"judge":   judge,
+	})
+}
+
+// GetRubric handles GET /api/v1/judging/rubric?event=...
+// Judges and admins can read the rubric of an event
+func (h *JudgingHandler) GetRubric(c *gin.Context) {
+	criteria, err := h.judgingService.GetRubric(c.Query("event"))
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve rubric")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"event":    c.Query("event"),
+		"criteria": criteria,
+	})
+}
+
+// SaveRubric handles PUT /api/v1/admin/judging/rubric
+// Admin-only endpoint replacing an event's weighted judging criteria
+func (h *JudgingHandler) SaveRubric(c *gin.Context) {
+	var req services.SaveRubricRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	criteria, err := h.judgingService.SaveRubric(&req)
+	if err != nil {
+		h.respondError(c, err, "Failed to save rubric")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"event":    req.Event,
+		"criteria": criteria,
+	})
+}
+
+// GetWorklist handles GET /api/v1/judging/projects?event=...
+// Judge-only endpoint listing the event's projects with the judge's own scores
+func (h *JudgingHandler) GetWorklist(c *gin.Context) {
+	worklist, err := h.judgingService.GetWorklist(middleware.CurrentUserID(c), c.Query("event"))
+	if err != nil {
+		h.respondError(c, err, "Failed to retrieve projects to judge")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    worklist,
+	})
+}
+
+// SubmitScores handles PUT /api/v1/judging/projects/:id/scores
+// Judge-only endpoint recording the judge's per-criterion scores for a project
+func (h *JudgingHandler) SubmitScores(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid ID format")
+	if !ok {
+		return
+	}
+
+	var req services.SubmitScoresRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	scores, err := h.judgingService.SubmitScores(middleware.CurrentUserID(c), projectID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to save scores")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"scores":  scores,
+	})
+}
+
+// GetLeaderboard handles GET /api/v1/admin/judging/leaderboard?event=...
+// Admin-only endpoint ranking an event's projects by normalized judging score
+func (h *JudgingHandler) GetLeaderboard(c *gin.Context) {
+	leaderboard, err := h.judgingService.GetLeaderboard(c.Query("event"))
+	if err != nil {
+		h.respondError(c, err, "Failed to compute leaderboard")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    leaderboard,
+	})
+}
+
+// AwardTopProjects handles POST /api/v1/admin/judging/awards
+// Admin-only endpoint granting awards to the top N projects of the leaderboard
+func (h *JudgingHandler) AwardTopProjects(c *gin.Context) {
+	var req services.AwardTopProjectsRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	winners, err := h.judgingService.AwardTopProjects(&req)
+	if err != nil {
+		h.respondError(c, err, "Failed to grant awards")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"winners": winners,
+	})
+}
+
+// respondError maps judging errors to HTTP responses
+func (h *JudgingHandler) respondError(c *gin.Context, err error, message string) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "NOT_FOUND",
+				"message": "Judge or project not found",
+			},
+		})
+		return
+	}
+
+	code, _, _ := strings.Cut(err.Error(), ":")
+	if status, ok := judgingErrorStatus[code]; ok {
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": strings.TrimPrefix(err.Error(), code+": "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": message,
+			"details": err.Error(),
+		},
+	})
+}
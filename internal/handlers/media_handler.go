@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverheadBytes allows for form fields and part headers on top of the file itself
+const multipartOverheadBytes = 1 << 20
+
+type MediaHandler struct {
+	mediaService *services.MediaService
+}
+
+func NewMediaHandler(mediaService *services.MediaService) *MediaHandler {
+	return &MediaHandler{
+		mediaService: mediaService,
+	}
+}
+
+// UploadImage handles POST /api/v1/uploads/images
+// Stores an image (multipart field "file") for use in a submission and returns its URL and thumbnail
+func (h *MediaHandler) UploadImage(c *gin.Context) {
+	file, size, ok := h.openFile(c)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	upload, err := h.mediaService.UploadImage(c.Request.Context(), file, size)
+	if err != nil {
+		h.respondError(c, err, "Failed to upload image")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"file":    upload,
+	})
+}
+
+// UploadProjectLogo handles POST /api/v1/admin/projects/:id/logo
+// Admin-only endpoint replacing a project's logo with an uploaded image
+func (h *MediaHandler) UploadProjectLogo(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_ID", "Invalid id format")
+	if !ok {
+		return
+	}
+	file, size, ok := h.openFile(c)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	upload, err := h.mediaService.SetProjectLogo(c.Request.Context(), projectID, file, size)
+	if err != nil {
+		h.respondError(c, err, "Failed to upload logo")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"file":    upload,
+	})
+}
+
+// UploadTeamMemberImage handles POST /api/v1/admin/projects/:id/team/:memberId/image
+// Admin-only endpoint replacing a team member's photo with an uploaded image
+func (h *MediaHandler) UploadTeamMemberImage(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_ID", "Invalid id format")
+	if !ok {
+		return
+	}
+	memberID, ok := parseUintParam(c, "memberId", "INVALID_ID", "Invalid memberId format")
+	if !ok {
+		return
+	}
+	file, size, ok := h.openFile(c)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	upload, err := h.mediaService.SetTeamMemberImage(c.Request.Context(), projectID, memberID, file, size)
+	if err != nil {
+		h.respondError(c, err, "Failed to upload team member photo")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"file":    upload,
+	})
+}
+
+// AddProjectMedia handles POST /api/v1/admin/projects/:id/media
+// Admin-only endpoint adding a screenshot or video (multipart fields "file" and "caption") to a project's gallery
+func (h *MediaHandler) AddProjectMedia(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_ID", "Invalid id format")
+	if !ok {
+		return
+	}
+	file, size, ok := h.openFile(c)
+	if !ok {
+		return
+	}
+	defer file.Close()
+
+	item, err := h.mediaService.AddProjectMedia(c.Request.Context(), projectID, file, size, c.PostForm("caption"))
+	if err != nil {
+		h.respondError(c, err, "Failed to add media")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"media":   item,
+	})
+}
+
+// ReorderProjectMedia handles PUT /api/v1/admin/projects/:id/media/order
+// Admin-only endpoint rearranging a project's gallery
+func (h *MediaHandler) ReorderProjectMedia(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_ID", "Invalid id format")
+	if !ok {
+		return
+	}
+
+	var req services.ReorderMediaRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	items, err := h.mediaService.ReorderProjectMedia(projectID, &req)
+	if err != nil {
+		h.respondError(c, err, "Failed to reorder media")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"media":   items,
+	})
+}
+
+// DeleteProjectMedia handles DELETE /api/v1/admin/projects/:id/media/:mediaId
+// Admin-only endpoint removing an item and its files from a project's gallery
+func (h *MediaHandler) DeleteProjectMedia(c *gin.Context) {
+	projectID, ok := parseUintParam(c, "id", "INVALID_ID", "Invalid id format")
+	if !ok {
+		return
+	}
+	mediaID, ok := parseUintParam(c, "mediaId", "INVALID_ID", "Invalid mediaId format")
+	if !ok {
+		return
+	}
+
+	if err := h.mediaService.DeleteProjectMedia(projectID, mediaID); err != nil {
+		h.respondError(c, err, "Failed to delete media")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Media deleted successfully",
+	})
+}
+
+// openFile caps the request body and opens the uploaded "file" field.
+// The returned file must be closed by the caller.
+func (h *MediaHandler) openFile(c *gin.Context) (multipart.File, int64, bool) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.mediaService.MaxUploadBytes()+multipartOverheadBytes)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "FILE_TOO_LARGE",
+					"message": "Upload exceeds the maximum size",
+				},
+			})
+			return nil, 0, false
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INVALID_REQUEST",
+				"message": "Multipart field \"file\" is required",
+				"details": err.Error(),
+			},
+		})
+		return nil, 0, false
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		h.respondError(c, err, "Failed to read upload")
+		return nil, 0, false
+	}
+	return file, header.Size, true
+}
+
+// respondError maps media errors to HTTP responses
+func (h *MediaHandler) respondError(c *gin.Context, err error, message string) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "NOT_FOUND",
+				"message": "Project, team member or media not found",
+			},
+		})
+		return
+	}
+
+	code, _, _ := strings.Cut(err.Error(), ":")
+	status := map[string]int{
+		"UNSUPPORTED_MEDIA_TYPE": http.StatusUnsupportedMediaType,
+		"FILE_TOO_LARGE":         http.StatusRequestEntityTooLarge,
+		"INVALID_IMAGE":          http.StatusBadRequest,
+		"INVALID_MEDIA_ORDER":    http.StatusBadRequest,
+	}[code]
+	if status != 0 {
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": strings.TrimPrefix(err.Error(), code+": "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_ERROR",
+			"message": message,
+			"details": err.Error(),
+		},
+	})
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"monad-devhub-be/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MetricsHandler struct {
+	reviewMetricsService *services.ReviewMetricsService
+}
+
+func NewMetricsHandler(reviewMetricsService *services.ReviewMetricsService) *MetricsHandler {
+	return &MetricsHandler{
+		reviewMetricsService: reviewMetricsService,
+	}
+}
+
+// GetReviewSLA handles GET /api/v1/admin/metrics/review-sla
+// Admin-only endpoint reporting review turnaround percentiles per window, event and reviewer
+func (h *MetricsHandler) GetReviewSLA(c *gin.Context) {
+	var req services.GetReviewSLARequest
+
+	// Bind query parameters
+	if !bindQuery(c, &req) {
+		return
+	}
+
+	response, err := h.reviewMetricsService.GetReviewSLA(&req)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "INVALID_WINDOW") || strings.HasPrefix(err.Error(), "INVALID_PERCENTILE") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "BAD_REQUEST",
+					"message": err.Error(),
+				},
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to compute review SLA metrics",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -1,21 +1,33 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"monad-devhub-be/internal/middleware"
+	"monad-devhub-be/internal/models"
 	"monad-devhub-be/internal/services"
 
 	"github.com/gin-gonic/gin"
 )
 
+// Headers that identify an anonymous voter
+const (
+	deviceTokenHeader = "X-Device-Token"
+	fingerprintHeader = "X-Client-Fingerprint"
+)
+
 type ProjectHandler struct {
 	projectService *services.ProjectService
+	likeService    *services.LikeService
 }
 
-func NewProjectHandler(projectService *services.ProjectService) *ProjectHandler {
+func NewProjectHandler(projectService *services.ProjectService, likeService *services.LikeService) *ProjectHandler {
 	return &ProjectHandler{
 		projectService: projectService,
+		likeService:    likeService,
 	}
 }
 
@@ -24,21 +36,37 @@ func (h *ProjectHandler) GetProjects(c *gin.Context) {
 	var req services.GetProjectsRequest
 
 	// Bind query parameters
-	if err := c.ShouldBindQuery(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+	if !bindQuery(c, &req) {
+		return
+	}
+
+	// Get projects from service
+	response, err := h.projectService.GetProjects(&req)
+	if err != nil {
+		// Unknown sort fields and stale cursors are the caller's mistake
+		if code, message, ok := strings.Cut(err.Error(), ": "); ok && (code == "INVALID_SORT" || code == "INVALID_CURSOR") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    code,
+					"message": message,
+				},
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
 			"error": gin.H{
-				"code":    "BAD_REQUEST",
-				"message": "Invalid query parameters",
+				"code":    "INTERNAL_SERVER_ERROR",
+				"message": "Failed to retrieve projects",
 				"details": err.Error(),
 			},
 		})
 		return
 	}
 
-	// Get projects from service
-	response, err := h.projectService.GetProjects(&req)
-	if err != nil {
+	// Flag the projects the caller has liked
+	if err := h.likeService.MarkLiked(response.Projects, h.voterInput(c)); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
 			"error": gin.H{
@@ -53,14 +81,29 @@ func (h *ProjectHandler) GetProjects(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// CreateProject handles POST /api/v1/projects (for direct project creation)
-func (h *ProjectHandler) CreateProject(c *gin.Context) {
-	c.JSON(http.StatusNotImplemented, gin.H{
-		"success": false,
-		"error": gin.H{
-			"code":    "NOT_IMPLEMENTED",
-			"message": "Direct project creation is not implemented. Use submissions endpoint instead.",
-		},
+// GetFeaturedProjects handles GET /api/v1/projects/featured
+// Returns the projects in the featured carousel right now, in order
+func (h *ProjectHandler) GetFeaturedProjects(c *gin.Context) {
+	projects, err := h.projectService.GetFeaturedProjects()
+	if err == nil {
+		// Flag the projects the caller has liked
+		err = h.likeService.MarkLiked(projects, h.voterInput(c))
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INTERNAL_SERVER_ERROR",
+				"message": "Failed to retrieve featured projects",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"projects": projects,
 	})
 }
 
@@ -93,6 +136,21 @@ func (h *ProjectHandler) GetProject(c *gin.Context) {
 		return
 	}
 
+	// Flag whether the caller has liked the project
+	projects := []models.Project{*project}
+	if err := h.likeService.MarkLiked(projects, h.voterInput(c)); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INTERNAL_SERVER_ERROR",
+				"message": "Failed to retrieve project",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+	project.LikedByMe = projects[0].LikedByMe
+
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
 		"project": project,
@@ -100,7 +158,18 @@ func (h *ProjectHandler) GetProject(c *gin.Context) {
 }
 
 // LikeProject handles POST /api/v1/projects/:id/like
+// Records the caller's like once; anonymous callers without a device token are issued one
 func (h *ProjectHandler) LikeProject(c *gin.Context) {
+	h.setLiked(c, true)
+}
+
+// UnlikeProject handles DELETE /api/v1/projects/:id/like
+// Removes the caller's like, if any
+func (h *ProjectHandler) UnlikeProject(c *gin.Context) {
+	h.setLiked(c, false)
+}
+
+func (h *ProjectHandler) setLiked(c *gin.Context, liked bool) {
 	// Parse project ID
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 32)
@@ -115,9 +184,45 @@ func (h *ProjectHandler) LikeProject(c *gin.Context) {
 		return
 	}
 
-	// Like project
-	err = h.projectService.LikeProject(uint(id))
+	var response *services.LikeResponse
+	message := "Project liked successfully"
+	if liked {
+		response, err = h.likeService.LikeProject(uint(id), h.voterInput(c))
+	} else {
+		response, err = h.likeService.UnlikeProject(uint(id), h.voterInput(c))
+		message = "Project unliked successfully"
+	}
 	if err != nil {
+		h.respondLikeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":     true,
+		"likes":       response.Likes,
+		"likedByMe":   response.LikedByMe,
+		"changed":     response.Changed,
+		"deviceToken": response.DeviceToken,
+		"message":     message,
+	})
+}
+
+// voterInput collects what the request reveals about who is liking
+func (h *ProjectHandler) voterInput(c *gin.Context) services.VoterInput {
+	in := services.VoterInput{
+		DeviceToken: c.GetHeader(deviceTokenHeader),
+		Fingerprint: c.GetHeader(fingerprintHeader),
+		IP:          c.ClientIP(),
+	}
+	if username := c.GetString("username"); username != "" {
+		in.Account = c.GetString("role") + ":" + username
+	}
+	return in
+}
+
+// respondLikeError maps like errors to HTTP responses
+func (h *ProjectHandler) respondLikeError(c *gin.Context, err error) {
+	if err.Error() == "record not found" {
 		c.JSON(http.StatusNotFound, gin.H{
 			"success": false,
 			"error": gin.H{
@@ -128,20 +233,410 @@ func (h *ProjectHandler) LikeProject(c *gin.Context) {
 		return
 	}
 
-	// Get updated project to return new likes count
-	project, err := h.projectService.GetProject(uint(id))
+	code, _, _ := strings.Cut(err.Error(), ":")
+	status := map[string]int{
+		"INVALID_DEVICE_TOKEN": http.StatusBadRequest,
+		"LIKE_LIMIT_REACHED":   http.StatusTooManyRequests,
+	}[code]
+	if status != 0 {
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": strings.TrimPrefix(err.Error(), code+": "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_SERVER_ERROR",
+			"message": "Failed to update like",
+			"details": err.Error(),
+		},
+	})
+}
+
+// ReconcileLikeCounts handles POST /api/v1/admin/likes/reconcile
+// Admin-only endpoint to recompute every project's likes counter
+func (h *ProjectHandler) ReconcileLikeCounts(c *gin.Context) {
+	corrected, err := h.likeService.ReconcileLikeCounts()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INTERNAL_SERVER_ERROR",
+				"message": "Failed to reconcile like counts",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"corrected": corrected,
+	})
+}
+
+// GetUnpublishedProjects handles GET /api/v1/admin/projects/unpublished
+// Admin-only preview of approved projects that are scheduled but not yet public
+func (h *ProjectHandler) GetUnpublishedProjects(c *gin.Context) {
+	projects, err := h.projectService.GetUnpublishedProjects()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INTERNAL_SERVER_ERROR",
+				"message": "Failed to retrieve unpublished projects",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"projects": projects,
+	})
+}
+
+// GetProjectPreview handles GET /api/v1/admin/projects/:id
+// Admin-only lookup that also returns projects that are not yet published
+func (h *ProjectHandler) GetProjectPreview(c *gin.Context) {
+	// Parse project ID
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INVALID_PROJECT_ID",
+				"message": "Invalid project ID format",
+			},
+		})
+		return
+	}
+
+	project, err := h.projectService.GetProjectForAdmin(uint(id))
 	if err != nil {
-		// Still return success even if we can't get updated count
-		c.JSON(http.StatusOK, gin.H{
-			"success": true,
-			"message": "Project liked successfully",
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "PROJECT_NOT_FOUND",
+				"message": "Project not found",
+			},
 		})
 		return
 	}
 
+	c.Header("ETag", services.ProjectETag(project))
 	c.JSON(http.StatusOK, gin.H{
+		"success":   true,
+		"project":   project,
+		"published": project.PublishedAt != nil,
+	})
+}
+
+// CreateProject handles POST /api/v1/admin/projects
+// Admin-only endpoint to create a project directly, without a submission
+func (h *ProjectHandler) CreateProject(c *gin.Context) {
+	var req services.CreateProjectRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	project, err := h.projectService.CreateProject(&req)
+	if err != nil {
+		h.respondAdminError(c, err, "Failed to create project")
+		return
+	}
+
+	c.Header("ETag", services.ProjectETag(project))
+	c.JSON(http.StatusCreated, gin.H{
 		"success": true,
-		"likes":   project.Likes,
-		"message": "Project liked successfully",
+		"project": project,
+	})
+}
+
+// UpdateProject handles PUT /api/v1/admin/projects/:id
+// Admin-only edit of any project field except its team; requires If-Match with the project's ETag
+func (h *ProjectHandler) UpdateProject(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	var req services.UpdateProjectRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	project, err := h.projectService.UpdateProject(id, c.GetHeader("If-Match"), &req, middleware.CurrentUserID(c))
+	if err != nil {
+		h.respondAdminError(c, err, "Failed to update project")
+		return
+	}
+
+	c.Header("ETag", services.ProjectETag(project))
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"project": project,
+	})
+}
+
+// AddTeamMember handles POST /api/v1/admin/projects/:id/team
+// Admin-only endpoint to add a team member; If-Match is checked when sent
+func (h *ProjectHandler) AddTeamMember(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	var req models.TeamMemberInput
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	project, err := h.projectService.AddTeamMember(id, c.GetHeader("If-Match"), req, middleware.CurrentUserID(c))
+	if err != nil {
+		h.respondAdminError(c, err, "Failed to add team member")
+		return
+	}
+
+	c.Header("ETag", services.ProjectETag(project))
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"project": project,
+	})
+}
+
+// UpdateTeamMember handles PUT /api/v1/admin/projects/:id/team/:memberId
+// Admin-only endpoint to change a team member's name or twitter handle; If-Match is checked when sent
+func (h *ProjectHandler) UpdateTeamMember(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+	memberID, ok := parseUintParam(c, "memberId", "INVALID_MEMBER_ID", "Invalid team member ID format")
+	if !ok {
+		return
+	}
+
+	var req models.TeamMemberInput
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	project, err := h.projectService.UpdateTeamMember(id, memberID, c.GetHeader("If-Match"), req, middleware.CurrentUserID(c))
+	if err != nil {
+		h.respondAdminError(c, err, "Failed to update team member")
+		return
+	}
+
+	c.Header("ETag", services.ProjectETag(project))
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"project": project,
+	})
+}
+
+// RemoveTeamMember handles DELETE /api/v1/admin/projects/:id/team/:memberId
+// Admin-only endpoint to remove a team member; If-Match is checked when sent
+func (h *ProjectHandler) RemoveTeamMember(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+	memberID, ok := parseUintParam(c, "memberId", "INVALID_MEMBER_ID", "Invalid team member ID format")
+	if !ok {
+		return
+	}
+
+	project, err := h.projectService.RemoveTeamMember(id, memberID, c.GetHeader("If-Match"), middleware.CurrentUserID(c))
+	if err != nil {
+		h.respondAdminError(c, err, "Failed to remove team member")
+		return
+	}
+
+	c.Header("ETag", services.ProjectETag(project))
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"project": project,
+	})
+}
+
+// DeleteProject handles DELETE /api/v1/admin/projects/:id
+// Admin-only soft delete; the project can be restored until it is purged
+func (h *ProjectHandler) DeleteProject(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	if err := h.projectService.DeleteProject(id); err != nil {
+		h.respondAdminError(c, err, "Failed to delete project")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Project deleted successfully",
+	})
+}
+
+// RestoreProject handles POST /api/v1/admin/projects/:id/restore
+// Admin-only endpoint to bring back a soft-deleted project
+func (h *ProjectHandler) RestoreProject(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	project, err := h.projectService.RestoreProject(id)
+	if err != nil {
+		h.respondAdminError(c, err, "Failed to restore project")
+		return
+	}
+
+	c.Header("ETag", services.ProjectETag(project))
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"project": project,
+	})
+}
+
+// PurgeProject handles DELETE /api/v1/admin/projects/:id/purge
+// Admin-only endpoint to permanently delete a soft-deleted project and its stored files
+func (h *ProjectHandler) PurgeProject(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	if err := h.projectService.PurgeProject(id); err != nil {
+		h.respondAdminError(c, err, "Failed to purge project")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Project purged successfully",
+	})
+}
+
+// GetDeletedProjects handles GET /api/v1/admin/projects/deleted
+// Admin-only list of soft-deleted projects that can be restored or purged
+func (h *ProjectHandler) GetDeletedProjects(c *gin.Context) {
+	projects, err := h.projectService.GetDeletedProjects()
+	if err != nil {
+		h.respondAdminError(c, err, "Failed to retrieve deleted projects")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"projects": projects,
+	})
+}
+
+// GetFlaggedFeaturedProjects handles GET /api/v1/admin/projects/featured
+// Admin-only list of every featured project, including scheduled and expired ones
+func (h *ProjectHandler) GetFlaggedFeaturedProjects(c *gin.Context) {
+	projects, err := h.projectService.GetFlaggedFeaturedProjects()
+	if err != nil {
+		h.respondAdminError(c, err, "Failed to retrieve featured projects")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"projects": projects,
+	})
+}
+
+// SetFeatured handles PUT /api/v1/admin/projects/:id/featured
+// Admin-only endpoint to feature a project within an optional window, or unfeature it
+func (h *ProjectHandler) SetFeatured(c *gin.Context) {
+	id, ok := parseUintParam(c, "id", "INVALID_PROJECT_ID", "Invalid project ID format")
+	if !ok {
+		return
+	}
+
+	var req services.FeatureProjectRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	project, err := h.projectService.SetFeatured(id, &req)
+	if err != nil {
+		h.respondAdminError(c, err, "Failed to update featured project")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"project": project,
+	})
+}
+
+// respondAdminError maps errors of the admin project endpoints to HTTP responses
+func (h *ProjectHandler) respondAdminError(c *gin.Context, err error, message string) {
+	if err.Error() == "record not found" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "PROJECT_NOT_FOUND",
+				"message": "Project or team member not found",
+			},
+		})
+		return
+	}
+
+	// Custom field errors are reported per field
+	var fieldErr *services.FieldValidationError
+	if errors.As(err, &fieldErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INVALID_EXTRA_FIELDS",
+				"message": "Some extra fields are invalid",
+				"fields":  fieldErr.Fields,
+			},
+		})
+		return
+	}
+
+	code, _, _ := strings.Cut(err.Error(), ":")
+	status := map[string]int{
+		"INVALID_PROJECT":        http.StatusBadRequest,
+		"INVALID_CATEGORIES":     http.StatusBadRequest,
+		"INVALID_EVENT":          http.StatusBadRequest,
+		"INVALID_TEAM_MEMBERS":   http.StatusBadRequest,
+		"DUPLICATE_PROJECT_NAME": http.StatusConflict,
+		"PROJECT_NOT_DELETED":    http.StatusConflict,
+		"PRECONDITION_FAILED":    http.StatusPreconditionFailed,
+		"PRECONDITION_REQUIRED":  http.StatusPreconditionRequired,
+	}[code]
+	if status != 0 {
+		c.JSON(status, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    code,
+				"message": strings.TrimPrefix(err.Error(), code+": "),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "INTERNAL_SERVER_ERROR",
+			"message": message,
+			"details": err.Error(),
+		},
 	})
 }
@@ -1,9 +1,14 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
+	"monad-devhub-be/internal/middleware"
 	"monad-devhub-be/internal/services"
 	"monad-devhub-be/internal/utils"
 
@@ -40,55 +45,13 @@ func (h *SubmissionHandler) SubmitProject(c *gin.Context) {
 		return
 	}
 
+	// Signed-in builders become the owner of the project once it is approved
+	req.OwnerAddress = middleware.CurrentBuilderAddress(c)
+
 	// Submit project through service (this generates the submission ID)
 	response, err := h.projectService.SubmitProject(&req)
 	if err != nil {
-		// Parse error to determine appropriate status code
-		if err.Error() == "DUPLICATE_PROJECT_NAME: Project with this name already exists" {
-			c.JSON(http.StatusConflict, gin.H{
-				"success": false,
-				"error": gin.H{
-					"code":    "DUPLICATE_PROJECT_NAME",
-					"message": "A project with this name already exists",
-				},
-			})
-			return
-		}
-
-		if err.Error() == "DUPLICATE_SUBMISSION: Submission with this project name already exists" {
-			c.JSON(http.StatusConflict, gin.H{
-				"success": false,
-				"error": gin.H{
-					"code":    "DUPLICATE_SUBMISSION",
-					"message": "A submission with this project name already exists",
-				},
-			})
-			return
-		}
-
-		// Handle validation errors
-		if err.Error() == "INVALID_CATEGORIES: Invalid categories provided" ||
-			err.Error() == "INVALID_EVENT: Invalid event provided" ||
-			err.Error() == "INVALID_TEAM_MEMBERS: All team members must have name and twitter" {
-			c.JSON(http.StatusBadRequest, gin.H{
-				"success": false,
-				"error": gin.H{
-					"code":    "VALIDATION_ERROR",
-					"message": err.Error(),
-				},
-			})
-			return
-		}
-
-		// Generic error
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"success": false,
-			"error": gin.H{
-				"code":    "SUBMISSION_FAILED",
-				"message": "Failed to submit project",
-				"details": err.Error(),
-			},
-		})
+		respondSubmissionError(c, err)
 		return
 	}
 
@@ -107,14 +70,20 @@ func (h *SubmissionHandler) GetSubmissionStatus(c *gin.Context) {
 			"success": false,
 			"error": gin.H{
 				"code":    "INVALID_SUBMISSION_ID",
-				"message": "Invalid submission ID format. Expected format: SUB-{timestamp}-{hash}",
+				"message": "Invalid submission ID. Check it for typos; expected format: SUB-{timestamp}-{hash}",
 			},
 		})
 		return
 	}
 
-	// Get submission from service
-	submission, err := h.submissionService.GetSubmissionByID(submissionID)
+	// The submitter's secret token or builder session unlocks private fields; admins see everything
+	accessToken := c.GetHeader("X-Submission-Token")
+	if accessToken == "" {
+		accessToken = c.Query("token")
+	}
+
+	// Get submission from service, redacted for the caller
+	submission, viewer, err := h.submissionService.GetSubmissionStatus(submissionID, accessToken, middleware.CurrentBuilderAddress(c), middleware.IsAdmin(c))
 	if err != nil {
 		if err.Error() == "record not found" {
 			c.JSON(http.StatusNotFound, gin.H{
@@ -153,40 +122,122 @@ func (h *SubmissionHandler) GetSubmissionStatus(c *gin.Context) {
 	}
 
 	// Return submission status
+	response := gin.H{
+		"submissionId":   submission.ID,
+		"status":         submission.Status,
+		"projectName":    submission.ProjectName,
+		"submittedAt":    submission.SubmittedAt,
+		"reviewedAt":     submission.ReviewedAt,
+		"feedback":       submission.Feedback,
+		"project":        submission.ApprovedProject,
+		"timeline":       timeline,
+		"access":         viewer.String(),
+		"redactedFields": services.RedactedSubmissionFields(viewer),
+	}
+	if viewer != services.ViewerPublic {
+		response["submission"] = submission
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RegenerateSubmissionToken handles POST /api/v1/admin/submissions/:submissionId/token
+// Admin-only endpoint to issue a new secret token, e.g. for submissions created before tokens existed
+func (h *SubmissionHandler) RegenerateSubmissionToken(c *gin.Context) {
+	submissionID := c.Param("submissionId")
+
+	// Validate submission ID format
+	if !utils.ValidateSubmissionID(submissionID) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INVALID_SUBMISSION_ID",
+				"message": "Invalid submission ID. Check it for typos",
+			},
+		})
+		return
+	}
+
+	token, err := h.submissionService.RegenerateSubmissionToken(submissionID)
+	if err != nil {
+		if err.Error() == "record not found" {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    "SUBMISSION_NOT_FOUND",
+					"message": "Submission not found",
+				},
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to regenerate submission token",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"submissionId": submission.ID,
-		"status":       submission.Status,
-		"projectName":  submission.ProjectName,
-		"submittedAt":  submission.SubmittedAt,
-		"reviewedAt":   submission.ReviewedAt,
-		"feedback":     submission.Feedback,
-		"project":      submission.ApprovedProject,
-		"timeline":     timeline,
+		"success":         true,
+		"submissionId":    submissionID,
+		"submissionToken": token,
 	})
 }
 
 // GetSubmissions handles GET /api/v1/submissions
+// Admin-only: the list includes private fields such as notes and reviewer feedback
 func (h *SubmissionHandler) GetSubmissions(c *gin.Context) {
 
 	// Parse query parameters
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
 	status := c.Query("status")
-	sortBy := c.DefaultQuery("sortBy", "submitted_at")
-	sortOrder := c.DefaultQuery("sortOrder", "DESC")
 
 	// Create request
 	req := &services.GetSubmissionsRequest{
 		Page:      page,
 		Limit:     limit,
 		Status:    status,
-		SortBy:    sortBy,
-		SortOrder: sortOrder,
+		Event:     c.Query("event"),
+		Category:  utils.RemoveEmpty(c.QueryArray("category")),
+		Search:    c.Query("search"),
+		SortBy:    c.Query("sortBy"),
+		SortOrder: c.Query("sortOrder"),
+		Cursor:    c.Query("cursor"),
+	}
+
+	// Parse optional filters
+	if err := parseSubmissionFilters(c, req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "BAD_REQUEST",
+				"message": "Invalid query parameters",
+				"details": err.Error(),
+			},
+		})
+		return
 	}
 
 	// Get submissions from service
 	response, err := h.submissionService.GetSubmissions(req)
 	if err != nil {
+		// Unknown sort fields and stale cursors are the caller's mistake
+		if code, message, ok := strings.Cut(err.Error(), ": "); ok && (code == "INVALID_SORT" || code == "INVALID_CURSOR") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error": gin.H{
+					"code":    code,
+					"message": message,
+				},
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"success": false,
 			"error": gin.H{
@@ -212,7 +263,7 @@ func (h *SubmissionHandler) ReviewSubmission(c *gin.Context) {
 			"success": false,
 			"error": gin.H{
 				"code":    "INVALID_SUBMISSION_ID",
-				"message": "Invalid submission ID format",
+				"message": "Invalid submission ID. Check it for typos",
 			},
 		})
 		return
@@ -220,10 +271,11 @@ func (h *SubmissionHandler) ReviewSubmission(c *gin.Context) {
 
 	// Parse review request
 	var reviewRequest struct {
-		Status           string   `json:"status" binding:"required,oneof=pending under_review approved rejected requires_changes"`
-		Feedback         *string  `json:"feedback,omitempty"`
-		ChangesRequested []string `json:"changesRequested,omitempty"`
-		ReviewerID       uint     `json:"reviewerId" binding:"required"`
+		Status           string     `json:"status" binding:"required,oneof=pending under_review approved rejected requires_changes"`
+		Feedback         *string    `json:"feedback,omitempty"`
+		ChangesRequested []string   `json:"changesRequested,omitempty"`
+		ReviewerID       uint       `json:"reviewerId" binding:"required"`
+		PublishAt        *time.Time `json:"publishAt,omitempty"` // Approve now, reveal the project later
 	}
 
 	if err := c.ShouldBindJSON(&reviewRequest); err != nil {
@@ -245,6 +297,7 @@ func (h *SubmissionHandler) ReviewSubmission(c *gin.Context) {
 		reviewRequest.Feedback,
 		reviewRequest.ChangesRequested,
 		&reviewRequest.ReviewerID,
+		reviewRequest.PublishAt,
 	)
 
 	if err != nil {
@@ -289,7 +342,7 @@ func (h *SubmissionHandler) UpdateProjectExtras(c *gin.Context) {
 			"success": false,
 			"error": gin.H{
 				"code":    "INVALID_SUBMISSION_ID",
-				"message": "Invalid submission ID format",
+				"message": "Invalid submission ID. Check it for typos",
 			},
 		})
 		return
@@ -360,3 +413,158 @@ func (h *SubmissionHandler) UpdateProjectExtras(c *gin.Context) {
 		"message":      "Project extras updated successfully",
 	})
 }
+
+// respondSubmissionError maps errors from the submission pipeline to HTTP responses
+func respondSubmissionError(c *gin.Context, err error) {
+	// Parse error to determine appropriate status code
+	if err.Error() == "DUPLICATE_PROJECT_NAME: Project with this name already exists" {
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "DUPLICATE_PROJECT_NAME",
+				"message": "A project with this name already exists",
+			},
+		})
+		return
+	}
+
+	if err.Error() == "DUPLICATE_SUBMISSION: Submission with this project name already exists" {
+		c.JSON(http.StatusConflict, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "DUPLICATE_SUBMISSION",
+				"message": "A submission with this project name already exists",
+			},
+		})
+		return
+	}
+
+	if err.Error() == "SUBMISSIONS_CLOSED: Submissions for this event are not open" {
+		c.JSON(http.StatusForbidden, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "SUBMISSIONS_CLOSED",
+				"message": "Submissions for this event are not open",
+			},
+		})
+		return
+	}
+
+	// Custom field errors are reported per field
+	var fieldErr *services.FieldValidationError
+	if errors.As(err, &fieldErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INVALID_EXTRA_FIELDS",
+				"message": "Some extra fields are invalid",
+				"fields":  fieldErr.Fields,
+			},
+		})
+		return
+	}
+
+	// Handle validation errors
+	if strings.HasPrefix(err.Error(), "INVALID_CONTRACT_ADDRESS:") ||
+		err.Error() == "INVALID_CATEGORIES: Invalid categories provided" ||
+		err.Error() == "INVALID_EVENT: Invalid event provided" ||
+		err.Error() == "INVALID_TEAM_MEMBERS: All team members must have name and twitter" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "VALIDATION_ERROR",
+				"message": err.Error(),
+			},
+		})
+		return
+	}
+
+	// Generic error
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"success": false,
+		"error": gin.H{
+			"code":    "SUBMISSION_FAILED",
+			"message": "Failed to submit project",
+			"details": err.Error(),
+		},
+	})
+}
+
+// BulkReviewSubmissions handles POST /api/v1/admin/submissions/bulk-review
+// Admin-only endpoint to apply one review decision to many submissions
+func (h *SubmissionHandler) BulkReviewSubmissions(c *gin.Context) {
+	var req services.BulkReviewRequest
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INVALID_REVIEW_DATA",
+				"message": "Invalid bulk review data",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	response, err := h.submissionService.BulkReviewSubmissions(&req)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INTERNAL_ERROR",
+				"message": "Failed to review submissions",
+				"details": err.Error(),
+			},
+		})
+		return
+	}
+
+	// Partial failures are reported per item with 207, a rolled back batch with 409
+	status := http.StatusOK
+	if response.RolledBack {
+		status = http.StatusConflict
+	} else if response.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+
+	c.JSON(status, response)
+}
+
+// parseSubmissionFilters parses the typed submission list filters from the query string
+func parseSubmissionFilters(c *gin.Context, req *services.GetSubmissionsRequest) error {
+	if value := c.Query("reviewerId"); value != "" {
+		reviewerID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid reviewerId: %s", value)
+		}
+		id := uint(reviewerID)
+		req.ReviewerID = &id
+	}
+
+	if value := c.Query("submittedFrom"); value != "" {
+		from, err := utils.ParseDateParam(value, false)
+		if err != nil {
+			return fmt.Errorf("invalid submittedFrom: %s", value)
+		}
+		req.SubmittedFrom = &from
+	}
+
+	if value := c.Query("submittedTo"); value != "" {
+		to, err := utils.ParseDateParam(value, true)
+		if err != nil {
+			return fmt.Errorf("invalid submittedTo: %s", value)
+		}
+		req.SubmittedTo = &to
+	}
+
+	if value := c.Query("hasGithub"); value != "" {
+		hasGithub, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid hasGithub: %s", value)
+		}
+		req.HasGithub = &hasGithub
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Record is one flattened row of an external export, keyed by column name
+type Record map[string]string
+
+// Format identifies a supported import file format
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatJSON Format = "json"
+)
+
+// ParseFormat resolves a format name, falling back to the file extension when name is empty
+func ParseFormat(name, filename string) (Format, error) {
+	if name == "" {
+		name = strings.TrimPrefix(filepath.Ext(filename), ".")
+	}
+	switch strings.ToLower(name) {
+	case "csv":
+		return FormatCSV, nil
+	case "json":
+		return FormatJSON, nil
+	default:
+		return "", fmt.Errorf("unsupported import format: %q", name)
+	}
+}
+
+// ReadRecords parses every row of the input into flattened records
+func ReadRecords(format Format, r io.Reader) ([]Record, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(r)
+	case FormatJSON:
+		return readJSON(r)
+	default:
+		return nil, fmt.Errorf("unsupported import format: %q", format)
+	}
+}
+
+// readCSV reads a CSV file whose first row holds the column names
+func readCSV(r io.Reader) ([]Record, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("import file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+	}
+
+	var records []Record
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		record := make(Record, len(header))
+		for i, column := range header {
+			if i < len(row) {
+				record[column] = strings.TrimSpace(row[i])
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// readJSON reads a JSON array of objects, or an object wrapping that array in a single field
+func readJSON(r io.Reader) ([]Record, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+
+	items, ok := document.([]interface{})
+	if !ok {
+		// Many platforms wrap the entries, e.g. {"submissions": [...]}
+		if wrapper, isObject := document.(map[string]interface{}); isObject && len(wrapper) == 1 {
+			for _, value := range wrapper {
+				items, ok = value.([]interface{})
+			}
+		}
+	}
+	if !ok {
+		return nil, errors.New("JSON import must be an array of objects")
+	}
+
+	records := make([]Record, 0, len(items))
+	for i, item := range items {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("JSON import entry %d is not an object", i+1)
+		}
+		record := make(Record)
+		flatten(record, "", object)
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// flatten stores nested JSON values under dotted keys (team.0.name).
+// Arrays of scalars are additionally stored joined by commas under their own key.
+func flatten(record Record, prefix string, value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			flatten(record, joinKey(prefix, key), child)
+		}
+	case []interface{}:
+		var scalars []string
+		for i, child := range v {
+			flatten(record, joinKey(prefix, strconv.Itoa(i)), child)
+			if scalar, ok := scalarString(child); ok {
+				scalars = append(scalars, scalar)
+			}
+		}
+		if len(scalars) == len(v) && prefix != "" {
+			record[prefix] = strings.Join(scalars, ",")
+		}
+	default:
+		if scalar, ok := scalarString(v); ok && prefix != "" {
+			record[prefix] = scalar
+		}
+	}
+}
+
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+func scalarString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", true
+	case string:
+		return strings.TrimSpace(v), true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,247 @@
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/utils"
+)
+
+// Mapping describes how the columns of an external export map onto submission fields
+type Mapping struct {
+	ExternalID    string                       `json:"externalId"`    // Column holding the platform's unique entry ID
+	Fields        map[string]string            `json:"fields"`        // Submission field (or "extraFields.<name>") -> source column
+	Defaults      map[string]string            `json:"defaults"`      // Submission field -> value used when the column is missing or empty
+	Values        map[string]map[string]string `json:"values"`        // Submission field -> source value -> hub value (e.g. event names)
+	ListSeparator string                       `json:"listSeparator"` // Separator for category lists (default ",")
+	TeamSeparator string                       `json:"teamSeparator"` // Separator between members in a single team column (default ";")
+	TeamMembers   []TeamMemberColumns          `json:"teamMembers"`   // Numbered team member columns, e.g. member1_name / member1_twitter
+}
+
+// TeamMemberColumns names the columns holding one team member
+type TeamMemberColumns struct {
+	Name    string `json:"name"`
+	Twitter string `json:"twitter"`
+}
+
+// mappableFields lists the submission fields a mapping may target
+var mappableFields = map[string]bool{
+	"projectName":     true,
+	"description":     true,
+	"photoLink":       true,
+	"event":           true,
+	"categories":      true,
+	"teamMembers":     true,
+	"githubLink":      true,
+	"websiteLink":     true,
+	"playLink":        true,
+	"howToPlay":       true,
+	"additionalNotes": true,
+	"award":           true, // Only used when importing approved projects
+}
+
+// extraFieldPrefix marks mapping keys that target an event's custom fields, e.g. "extraFields.contractAddress"
+const extraFieldPrefix = "extraFields."
+
+// isMappable reports whether a mapping may target the field
+func isMappable(field string) bool {
+	return mappableFields[field] || (strings.HasPrefix(field, extraFieldPrefix) && len(field) > len(extraFieldPrefix))
+}
+
+// Entry is one import row mapped onto submission fields
+type Entry struct {
+	ExternalID      string
+	PhotoLink       string
+	ProjectName     string
+	Description     string
+	Event           string
+	Categories      []string
+	TeamMembers     []models.TeamMemberInput
+	GithubLink      *string
+	WebsiteLink     *string
+	PlayLink        string
+	HowToPlay       string
+	AdditionalNotes *string
+	Award           string
+	ExtraFields     map[string]string // Custom field name -> text value, converted using the event's form schema
+	ListSeparator   string            // Separator for list-valued custom fields
+}
+
+// LoadMapping decodes and validates a JSON mapping config
+func LoadMapping(r io.Reader) (*Mapping, error) {
+	var mapping Mapping
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&mapping); err != nil {
+		return nil, fmt.Errorf("invalid mapping config: %w", err)
+	}
+	if err := mapping.Validate(); err != nil {
+		return nil, err
+	}
+	return &mapping, nil
+}
+
+// Validate checks that the mapping only targets known fields and identifies rows
+func (m *Mapping) Validate() error {
+	if strings.TrimSpace(m.ExternalID) == "" {
+		return errors.New("invalid mapping config: externalId column is required")
+	}
+
+	var unknown []string
+	for _, fields := range []map[string]string{m.Fields, m.Defaults} {
+		for field := range fields {
+			if !isMappable(field) {
+				unknown = append(unknown, field)
+			}
+		}
+	}
+	for field := range m.Values {
+		if !isMappable(field) {
+			unknown = append(unknown, field)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("invalid mapping config: unknown fields %s", strings.Join(utils.RemoveDuplicates(unknown), ", "))
+	}
+
+	for i, columns := range m.TeamMembers {
+		if columns.Name == "" {
+			return fmt.Errorf("invalid mapping config: teamMembers[%d] needs a name column", i)
+		}
+	}
+	return nil
+}
+
+// Apply maps a record onto submission fields
+func (m *Mapping) Apply(record Record) *Entry {
+	entry := &Entry{
+		ExternalID:      record[m.ExternalID],
+		PhotoLink:       m.value(record, "photoLink"),
+		ProjectName:     m.value(record, "projectName"),
+		Description:     m.value(record, "description"),
+		Event:           m.value(record, "event"),
+		GithubLink:      optional(m.value(record, "githubLink")),
+		WebsiteLink:     optional(m.value(record, "websiteLink")),
+		PlayLink:        m.value(record, "playLink"),
+		HowToPlay:       m.value(record, "howToPlay"),
+		AdditionalNotes: optional(m.value(record, "additionalNotes")),
+		Award:           m.value(record, "award"),
+		ListSeparator:   m.listSeparator(),
+	}
+
+	// Custom fields keep their text; the importer does not know the event's schema
+	for _, fields := range []map[string]string{m.Fields, m.Defaults} {
+		for field := range fields {
+			name, ok := strings.CutPrefix(field, extraFieldPrefix)
+			if !ok {
+				continue
+			}
+			if value := m.value(record, field); value != "" {
+				if entry.ExtraFields == nil {
+					entry.ExtraFields = make(map[string]string)
+				}
+				entry.ExtraFields[name] = value
+			}
+		}
+	}
+
+	// Categories are mapped one by one so the value map can rename each
+	for _, category := range splitList(m.raw(record, "categories"), m.listSeparator()) {
+		entry.Categories = append(entry.Categories, m.translate("categories", category))
+	}
+	entry.Categories = utils.RemoveDuplicates(entry.Categories)
+
+	// Team members come from numbered columns, a single combined column, or both
+	for _, columns := range m.TeamMembers {
+		name := record[columns.Name]
+		if name == "" {
+			continue
+		}
+		entry.TeamMembers = append(entry.TeamMembers, models.TeamMemberInput{
+			Name:    name,
+			Twitter: utils.TrimTwitterHandle(record[columns.Twitter]),
+		})
+	}
+	for _, member := range splitList(m.raw(record, "teamMembers"), m.teamSeparator()) {
+		entry.TeamMembers = append(entry.TeamMembers, parseTeamMember(member))
+	}
+
+	return entry
+}
+
+// raw returns the untranslated column value for a field, or its default
+func (m *Mapping) raw(record Record, field string) string {
+	if column, ok := m.Fields[field]; ok {
+		if value := record[column]; value != "" {
+			return value
+		}
+	}
+	return m.Defaults[field]
+}
+
+// value returns the column value for a field after applying the value map
+func (m *Mapping) value(record Record, field string) string {
+	return m.translate(field, m.raw(record, field))
+}
+
+func (m *Mapping) translate(field, value string) string {
+	if translated, ok := m.Values[field][value]; ok {
+		return translated
+	}
+	return value
+}
+
+func (m *Mapping) listSeparator() string {
+	if m.ListSeparator == "" {
+		return ","
+	}
+	return m.ListSeparator
+}
+
+func (m *Mapping) teamSeparator() string {
+	if m.TeamSeparator == "" {
+		return ";"
+	}
+	return m.TeamSeparator
+}
+
+func splitList(value, separator string) []string {
+	if value == "" {
+		return nil
+	}
+	parts := strings.Split(value, separator)
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	return utils.RemoveEmpty(parts)
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// teamMemberPattern matches "Name (@handle)", "Name @handle" and "Name | handle"
+var teamMemberPattern = regexp.MustCompile(`^(.*?)\s*(?:\(\s*@?([^)]*?)\s*\)|@(\S+)|\|\s*@?(\S+))$`)
+
+// parseTeamMember splits a combined team member entry into name and twitter handle
+func parseTeamMember(value string) models.TeamMemberInput {
+	match := teamMemberPattern.FindStringSubmatch(value)
+	if match == nil {
+		return models.TeamMemberInput{Name: value}
+	}
+	handle := match[2] + match[3] + match[4]
+	return models.TeamMemberInput{
+		Name:    strings.TrimSpace(match[1]),
+		Twitter: utils.TrimTwitterHandle(handle),
+	}
+}
@@ -0,0 +1,228 @@
+// Package jsonschema implements the subset of JSON Schema used to describe custom submission fields.
+//
+// Supported keywords: type, properties, required, additionalProperties (boolean), enum,
+// minLength, maxLength, pattern, format (uri, email, date, date-time, evm-address),
+// minimum, maximum, exclusiveMinimum, exclusiveMaximum, items, minItems, maxItems and
+// uniqueItems, plus the annotations $schema, $id, title, description, default and examples.
+// Keywords starting with "x-" are left to the frontend (e.g. "x-placeholder"), except "x-public",
+// which marks a property whose values may be shown publicly.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Schema is a parsed JSON Schema
+type Schema struct {
+	Type                 TypeList           `json:"type,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum     *float64           `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     *float64           `json:"exclusiveMaximum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	UniqueItems          bool               `json:"uniqueItems,omitempty"`
+	Public               bool               `json:"x-public,omitempty"` // Values may be shown publicly
+
+	pattern *regexp.Regexp
+}
+
+// TypeList holds the "type" keyword, which may be a single type or a list of types
+type TypeList []string
+
+// UnmarshalJSON accepts both "string" and ["string", "null"]
+func (t *TypeList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = TypeList{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("type must be a string or an array of strings")
+	}
+	*t = list
+	return nil
+}
+
+// Has reports whether the list allows the given type; an empty list allows every type
+func (t TypeList) Has(name string) bool {
+	if len(t) == 0 {
+		return true
+	}
+	for _, allowed := range t {
+		if allowed == name || (name == "integer" && allowed == "number") {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	knownKeywords = map[string]bool{
+		"$schema": true, "$id": true, "title": true, "description": true, "default": true, "examples": true,
+		"type": true, "properties": true, "required": true, "additionalProperties": true, "enum": true,
+		"minLength": true, "maxLength": true, "pattern": true, "format": true,
+		"minimum": true, "maximum": true, "exclusiveMinimum": true, "exclusiveMaximum": true,
+		"items": true, "minItems": true, "maxItems": true, "uniqueItems": true,
+	}
+	knownTypes = map[string]bool{
+		"object": true, "array": true, "string": true, "number": true, "integer": true, "boolean": true, "null": true,
+	}
+)
+
+// Parse decodes and checks a schema. Unsupported keywords are rejected so that a schema
+// never silently validates less than its author expects.
+func Parse(data []byte) (*Schema, error) {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("schema is not valid JSON: %v", err)
+	}
+	if err := checkKeywords(raw, ""); err != nil {
+		return nil, err
+	}
+
+	var schema Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, fmt.Errorf("invalid schema: %v", err)
+	}
+	if err := schema.compile(""); err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+// MustBeObject checks that the schema describes an object with named properties, as form schemas do
+func (s *Schema) MustBeObject() error {
+	if len(s.Type) != 1 || s.Type[0] != "object" {
+		return fmt.Errorf(`the root schema must have "type": "object"`)
+	}
+	return nil
+}
+
+// PublicProperties returns the names of the properties marked "x-public", sorted
+func (s *Schema) PublicProperties() []string {
+	var names []string
+	for name, property := range s.Properties {
+		if property.Public {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// checkKeywords walks the raw schema and reports unknown keywords with their location
+func checkKeywords(raw interface{}, path string) error {
+	object, ok := raw.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s must be an object", describe(path))
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if strings.HasPrefix(key, "x-") {
+			continue
+		}
+		if !knownKeywords[key] {
+			return fmt.Errorf("unsupported keyword %q in %s", key, describe(path))
+		}
+	}
+	if properties, ok := object["properties"].(map[string]interface{}); ok {
+		for name, property := range properties {
+			if err := checkKeywords(property, joinPath(path, name)); err != nil {
+				return err
+			}
+		}
+	}
+	if items, ok := object["items"]; ok {
+		if err := checkKeywords(items, path+"[]"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compile validates keyword values and precompiles patterns
+func (s *Schema) compile(path string) error {
+	for _, name := range s.Type {
+		if !knownTypes[name] {
+			return fmt.Errorf("unknown type %q in %s", name, describe(path))
+		}
+	}
+	if s.Pattern != "" {
+		pattern, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern in %s: %v", describe(path), err)
+		}
+		s.pattern = pattern
+	}
+	if s.Format != "" && formats[s.Format] == nil {
+		return fmt.Errorf("unsupported format %q in %s", s.Format, describe(path))
+	}
+	for _, name := range s.Required {
+		if s.Properties[name] == nil && s.AdditionalProperties != nil && !*s.AdditionalProperties {
+			return fmt.Errorf("required property %q is not defined in %s", name, describe(path))
+		}
+	}
+	for name, property := range s.Properties {
+		if property == nil {
+			return fmt.Errorf("%s must be an object", describe(joinPath(path, name)))
+		}
+		if err := property.compile(joinPath(path, name)); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		if err := s.Items.compile(path + "[]"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Compact returns the schema document without insignificant whitespace
+func Compact(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// joinPath appends a property name to a field path
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// describe names a location in the schema for error messages
+func describe(path string) string {
+	if path == "" {
+		return "the root schema"
+	}
+	return "property " + path
+}
@@ -0,0 +1,99 @@
+package jsonschema
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		wantErr string
+	}{
+		{name: "empty object", schema: `{}`},
+		{name: "annotations and extensions", schema: `{"$schema": "https://json-schema.org/draft/2020-12/schema", "title": "Form", "type": "object", "x-order": ["a"], "properties": {"a": {"type": "string", "default": "", "examples": ["x"], "x-placeholder": "A", "x-public": true}}}`},
+		{name: "type list", schema: `{"type": ["string", "null"]}`},
+		{name: "nested items", schema: `{"type": "array", "items": {"type": "object", "properties": {"url": {"type": "string", "format": "uri"}}}}`},
+		{name: "required without closed properties", schema: `{"type": "object", "required": ["anything"]}`},
+		{name: "not json", schema: `{"type":`, wantErr: "schema is not valid JSON"},
+		{name: "not an object", schema: `[]`, wantErr: "the root schema must be an object"},
+		{name: "unsupported keyword", schema: `{"type": "object", "oneOf": []}`, wantErr: `unsupported keyword "oneOf" in the root schema`},
+		{name: "unsupported nested keyword", schema: `{"properties": {"a": {"$ref": "#/x"}}}`, wantErr: `unsupported keyword "$ref" in property a`},
+		{name: "unsupported items keyword", schema: `{"properties": {"a": {"items": {"const": 1}}}}`, wantErr: `unsupported keyword "const" in property a[]`},
+		{name: "property not an object", schema: `{"properties": {"a": true}}`, wantErr: "property a must be an object"},
+		{name: "unknown type", schema: `{"properties": {"a": {"type": "date"}}}`, wantErr: `unknown type "date" in property a`},
+		{name: "type not a string", schema: `{"type": 3}`, wantErr: "type must be a string or an array of strings"},
+		{name: "bad pattern", schema: `{"properties": {"a": {"pattern": "("}}}`, wantErr: "invalid pattern in property a"},
+		{name: "unknown format", schema: `{"properties": {"a": {"items": {"format": "ipv4"}}}}`, wantErr: `unsupported format "ipv4" in property a[]`},
+		{name: "required but closed", schema: `{"additionalProperties": false, "required": ["a"]}`, wantErr: `required property "a" is not defined in the root schema`},
+		{name: "wrong keyword type", schema: `{"minLength": "3"}`, wantErr: "invalid schema"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.schema))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMustBeObject(t *testing.T) {
+	tests := []struct {
+		schema string
+		ok     bool
+	}{
+		{`{"type": "object"}`, true},
+		{`{"type": ["object"]}`, true},
+		{`{}`, false},
+		{`{"type": "array"}`, false},
+		{`{"type": ["object", "null"]}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schema, func(t *testing.T) {
+			schema := mustParse(t, tt.schema)
+			if err := schema.MustBeObject(); (err == nil) != tt.ok {
+				t.Errorf("MustBeObject() = %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestPublicProperties(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   []string
+	}{
+		{name: "none", schema: `{"type": "object", "properties": {"a": {}, "b": {"x-public": false}}}`},
+		{name: "sorted", schema: `{"type": "object", "properties": {"z": {"x-public": true}, "a": {"x-public": true}, "m": {}}}`, want: []string{"a", "z"}},
+		{name: "only top level", schema: `{"type": "object", "properties": {"a": {"type": "object", "properties": {"b": {"x-public": true}}}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mustParse(t, tt.schema).PublicProperties(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PublicProperties() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func mustParse(t *testing.T, schema string) *Schema {
+	t.Helper()
+	parsed, err := Parse([]byte(schema))
+	if err != nil {
+		t.Fatalf("Parse(%s): %v", schema, err)
+	}
+	return parsed
+}
@@ -0,0 +1,270 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// FieldError describes why one field failed validation
+type FieldError struct {
+	Field   string `json:"field"` // Path of the field, e.g. "links[0].url"; empty for the document itself
+	Message string `json:"message"`
+}
+
+// formats maps supported "format" values to their checks
+var formats = map[string]func(string) bool{
+	"uri": func(value string) bool {
+		u, err := url.Parse(value)
+		return err == nil && u.Scheme != "" && u.Host != ""
+	},
+	"email": func(value string) bool {
+		address, err := mail.ParseAddress(value)
+		return err == nil && address.Address == value
+	},
+	"date": func(value string) bool {
+		_, err := time.Parse("2006-01-02", value)
+		return err == nil
+	},
+	"date-time": func(value string) bool {
+		_, err := time.Parse(time.RFC3339, value)
+		return err == nil
+	},
+	"evm-address": regexp.MustCompile(`^0x[0-9a-fA-F]{40}$`).MatchString,
+}
+
+// formatNames are the human-readable names used in format errors
+var formatNames = map[string]string{
+	"uri":         "a URL including its scheme",
+	"email":       "an email address",
+	"date":        "a date (YYYY-MM-DD)",
+	"date-time":   "an RFC 3339 timestamp",
+	"evm-address": "a 0x-prefixed address of 40 hex characters",
+}
+
+// Validate checks a decoded JSON value (as produced by encoding/json) against the schema
+func (s *Schema) Validate(value interface{}) []FieldError {
+	var errs []FieldError
+	s.validate(value, "", &errs)
+	return errs
+}
+
+func (s *Schema) validate(value interface{}, path string, errs *[]FieldError) {
+	fail := func(format string, args ...interface{}) {
+		*errs = append(*errs, FieldError{Field: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	kind := typeOf(value)
+	if !s.Type.Has(kind) {
+		fail("must be %s", describeTypes(s.Type))
+		return
+	}
+
+	if len(s.Enum) > 0 && !containsValue(s.Enum, value) {
+		fail("must be one of %s", describeEnum(s.Enum))
+		return
+	}
+
+	switch v := value.(type) {
+	case string:
+		length := utf8.RuneCountInString(v)
+		if s.MinLength != nil && length < *s.MinLength {
+			if *s.MinLength == 1 {
+				fail("must not be empty")
+			} else {
+				fail("must be at least %d characters", *s.MinLength)
+			}
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			fail("must be at most %d characters", *s.MaxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			fail("has an invalid format")
+		}
+		if s.Format != "" && !formats[s.Format](v) {
+			fail("must be %s", formatNames[s.Format])
+		}
+
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			fail("must be at least %s", formatNumber(*s.Minimum))
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			fail("must be at most %s", formatNumber(*s.Maximum))
+		}
+		if s.ExclusiveMinimum != nil && v <= *s.ExclusiveMinimum {
+			fail("must be greater than %s", formatNumber(*s.ExclusiveMinimum))
+		}
+		if s.ExclusiveMaximum != nil && v >= *s.ExclusiveMaximum {
+			fail("must be less than %s", formatNumber(*s.ExclusiveMaximum))
+		}
+
+	case []interface{}:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			fail("must contain at least %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			fail("must contain at most %d items", *s.MaxItems)
+		}
+		if s.UniqueItems {
+			seen := make(map[string]bool, len(v))
+			for _, item := range v {
+				key := canonical(item)
+				if seen[key] {
+					fail("must not contain duplicate items")
+					break
+				}
+				seen[key] = true
+			}
+		}
+		if s.Items != nil {
+			for i, item := range v {
+				s.Items.validate(item, fmt.Sprintf("%s[%d]", path, i), errs)
+			}
+		}
+
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if missing(v[name]) {
+				*errs = append(*errs, FieldError{Field: joinPath(path, name), Message: "is required"})
+			}
+		}
+
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property := s.Properties[name]
+			if property == nil {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					*errs = append(*errs, FieldError{Field: joinPath(path, name), Message: "is not a known field"})
+				}
+				continue
+			}
+			if v[name] == nil && !property.Type.Has("null") {
+				continue // Optional fields may be sent as null; required ones were reported above
+			}
+			property.validate(v[name], joinPath(path, name), errs)
+		}
+	}
+}
+
+// Coerce converts a text value (e.g. a CSV cell) to the type the schema expects.
+// Values that cannot be converted are returned unchanged so validation reports them.
+func (s *Schema) Coerce(text string, listSeparator string) interface{} {
+	text = strings.TrimSpace(text)
+	switch {
+	case s.Type.Has("string"):
+		return text
+	case s.Type.Has("integer"), s.Type.Has("number"):
+		if number, err := strconv.ParseFloat(text, 64); err == nil {
+			return number
+		}
+	case s.Type.Has("boolean"):
+		if flag, err := strconv.ParseBool(text); err == nil {
+			return flag
+		}
+	case s.Type.Has("array"):
+		items := []interface{}{}
+		for _, part := range strings.Split(text, listSeparator) {
+			if part = strings.TrimSpace(part); part != "" {
+				if s.Items != nil {
+					items = append(items, s.Items.Coerce(part, listSeparator))
+				} else {
+					items = append(items, part)
+				}
+			}
+		}
+		return items
+	}
+	return text
+}
+
+// typeOf returns the JSON Schema type of a decoded value
+func typeOf(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) && !math.IsInf(v, 0) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// missing reports whether a required value counts as not provided
+func missing(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	text, ok := value.(string)
+	return ok && strings.TrimSpace(text) == ""
+}
+
+// describeTypes renders a type list for error messages, e.g. "a string or null"
+func describeTypes(types TypeList) string {
+	names := map[string]string{
+		"object": "an object", "array": "a list", "string": "text", "number": "a number",
+		"integer": "a whole number", "boolean": "true or false", "null": "null",
+	}
+	parts := make([]string, len(types))
+	for i, name := range types {
+		parts[i] = names[name]
+	}
+	return strings.Join(parts, " or ")
+}
+
+// describeEnum renders the allowed values for error messages
+func describeEnum(values []interface{}) string {
+	parts := make([]string, len(values))
+	for i, value := range values {
+		parts[i] = canonical(value)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// containsValue reports whether value equals one of the candidates
+func containsValue(candidates []interface{}, value interface{}) bool {
+	key := canonical(value)
+	for _, candidate := range candidates {
+		if canonical(candidate) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// canonical encodes a value as JSON for comparisons; encoding/json sorts object keys
+func canonical(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// formatNumber renders a limit without trailing zeros
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
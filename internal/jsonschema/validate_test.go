@@ -0,0 +1,136 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const testSchema = `{
+	"type": "object",
+	"additionalProperties": false,
+	"required": ["name", "team"],
+	"properties": {
+		"name": {"type": "string", "minLength": 1, "maxLength": 5},
+		"code": {"type": "string", "minLength": 3, "pattern": "^[A-Z]+$"},
+		"site": {"type": "string", "format": "uri"},
+		"email": {"type": "string", "format": "email"},
+		"launch": {"type": "string", "format": "date"},
+		"deployed": {"type": "string", "format": "date-time"},
+		"wallet": {"type": "string", "format": "evm-address"},
+		"track": {"enum": ["defi", "games", 3]},
+		"size": {"type": "integer", "minimum": 1, "maximum": 5},
+		"score": {"type": "number", "exclusiveMinimum": 0, "exclusiveMaximum": 1.5},
+		"public": {"type": "boolean"},
+		"note": {"type": ["string", "null"]},
+		"tags": {"type": "array", "items": {"type": "string"}, "minItems": 1, "maxItems": 2, "uniqueItems": true},
+		"team": {
+			"type": "array",
+			"items": {"type": "object", "required": ["handle"], "properties": {"handle": {"type": "string"}}}
+		}
+	}
+}`
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []FieldError
+	}{
+		{name: "valid", value: `{"name": "Dex", "team": [], "code": "ABC", "site": "https://dex.xyz", "email": "a@b.co", "launch": "2024-02-29", "deployed": "2024-01-01T10:00:00+02:00", "wallet": "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23", "track": 3, "size": 5, "score": 1.25, "public": false, "note": null, "tags": ["a", "b"]}`},
+		{name: "optional null", value: `{"name": "Dex", "team": [], "site": null, "size": null}`},
+		{name: "not an object", value: `[]`, want: []FieldError{{Field: "", Message: "must be an object"}}},
+		{name: "required", value: `{"name": "  "}`, want: []FieldError{
+			{Field: "name", Message: "is required"},
+			{Field: "team", Message: "is required"},
+		}},
+		{name: "unknown field", value: `{"name": "a", "team": [], "extra": 1}`, want: []FieldError{{Field: "extra", Message: "is not a known field"}}},
+		{name: "string limits count runes", value: `{"name": "ééééé", "team": [], "code": "AB"}`, want: []FieldError{{Field: "code", Message: "must be at least 3 characters"}}},
+		{name: "empty string", value: `{"name": "", "team": []}`, want: []FieldError{
+			{Field: "name", Message: "is required"},
+			{Field: "name", Message: "must not be empty"},
+		}},
+		{name: "pattern", value: `{"name": "a", "team": [], "code": "abc"}`, want: []FieldError{{Field: "code", Message: "has an invalid format"}}},
+		{name: "formats", value: `{"name": "a", "team": [], "site": "dex.xyz", "email": "Dex <a@b.co>", "launch": "2023-02-29", "deployed": "2024-01-01", "wallet": "0x123"}`, want: []FieldError{
+			{Field: "deployed", Message: "must be an RFC 3339 timestamp"},
+			{Field: "email", Message: "must be an email address"},
+			{Field: "launch", Message: "must be a date (YYYY-MM-DD)"},
+			{Field: "site", Message: "must be a URL including its scheme"},
+			{Field: "wallet", Message: "must be a 0x-prefixed address of 40 hex characters"},
+		}},
+		{name: "enum", value: `{"name": "a", "team": [], "track": "3"}`, want: []FieldError{{Field: "track", Message: `must be one of "defi", "games", 3`}}},
+		{name: "types", value: `{"name": 1, "team": {}, "public": "yes", "note": 2, "size": 2.5}`, want: []FieldError{
+			{Field: "name", Message: "must be text"},
+			{Field: "note", Message: "must be text or null"},
+			{Field: "public", Message: "must be true or false"},
+			{Field: "size", Message: "must be a whole number"},
+			{Field: "team", Message: "must be a list"},
+		}},
+		{name: "number bounds", value: `{"name": "a", "team": [], "size": 0, "score": 0}`, want: []FieldError{
+			{Field: "score", Message: "must be greater than 0"},
+			{Field: "size", Message: "must be at least 1"},
+		}},
+		{name: "upper bounds", value: `{"name": "a", "team": [], "size": 6, "score": 1.5}`, want: []FieldError{
+			{Field: "score", Message: "must be less than 1.5"},
+			{Field: "size", Message: "must be at most 5"},
+		}},
+		{name: "array limits", value: `{"name": "a", "team": [], "tags": []}`, want: []FieldError{{Field: "tags", Message: "must contain at least 1 items"}}},
+		{name: "array items", value: `{"name": "a", "team": [], "tags": ["x", "x", 1]}`, want: []FieldError{
+			{Field: "tags", Message: "must contain at most 2 items"},
+			{Field: "tags", Message: "must not contain duplicate items"},
+			{Field: "tags[2]", Message: "must be text"},
+		}},
+		{name: "nested objects", value: `{"name": "a", "team": [{"handle": "x"}, {}, {"handle": 1}]}`, want: []FieldError{
+			{Field: "team[1].handle", Message: "is required"},
+			{Field: "team[2].handle", Message: "must be text"},
+		}},
+	}
+
+	schema := mustParse(t, testSchema)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var value interface{}
+			if err := json.Unmarshal([]byte(tt.value), &value); err != nil {
+				t.Fatal(err)
+			}
+			if got := schema.Validate(value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() =\n  %v\nwant\n  %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoerce(t *testing.T) {
+	schema := mustParse(t, `{"type": "object", "properties": {
+		"name": {"type": "string"},
+		"size": {"type": "integer"},
+		"public": {"type": "boolean"},
+		"tags": {"type": "array", "items": {"type": "string"}},
+		"scores": {"type": "array", "items": {"type": "number"}},
+		"any": {}
+	}}`)
+
+	tests := []struct {
+		property string
+		text     string
+		want     interface{}
+	}{
+		{"name", "  42 ", "42"},
+		{"size", "42", 42.0},
+		{"size", "many", "many"},
+		{"public", "true", true},
+		{"public", "maybe", "maybe"},
+		{"tags", " a; b ;;c ", []interface{}{"a", "b", "c"}},
+		{"tags", "", []interface{}{}},
+		{"scores", "1.5; x", []interface{}{1.5, "x"}},
+		{"any", " text ", "text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.property+"/"+tt.text, func(t *testing.T) {
+			if got := schema.Properties[tt.property].Coerce(tt.text, ";"); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Coerce(%q) = %#v, want %#v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
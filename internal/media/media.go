@@ -0,0 +1,176 @@
+// Package media validates uploaded images and videos and generates image thumbnails.
+package media
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+)
+
+// Kinds of media
+const (
+	KindImage = "image"
+	KindVideo = "video"
+)
+
+// ThumbnailSize is the longest side of generated thumbnails in pixels
+const ThumbnailSize = 480
+
+// maxImagePixels rejects images that would need too much memory to decode, about 64 MB as RGBA
+const maxImagePixels = 16_000_000
+
+// Supported content types and the file extensions they are stored with
+var (
+	imageTypes = map[string]string{
+		"image/png":  ".png",
+		"image/jpeg": ".jpg",
+		"image/gif":  ".gif",
+	}
+	videoTypes = map[string]string{
+		"video/mp4":  ".mp4",
+		"video/webm": ".webm",
+	}
+)
+
+// ErrInvalidImage is returned for image files that cannot be decoded
+var ErrInvalidImage = errors.New("media: invalid or unsupported image")
+
+// Detect sniffs the content type from the first bytes of a file; the declared type is not trusted
+func Detect(header []byte) (contentType, kind string, ok bool) {
+	contentType = http.DetectContentType(header)
+	if _, ok := imageTypes[contentType]; ok {
+		return contentType, KindImage, true
+	}
+	if _, ok := videoTypes[contentType]; ok {
+		return contentType, KindVideo, true
+	}
+	return contentType, "", false
+}
+
+// Extension returns the file extension for a supported content type
+func Extension(contentType string) string {
+	if ext, ok := imageTypes[contentType]; ok {
+		return ext
+	}
+	return videoTypes[contentType]
+}
+
+// Thumbnail is a downscaled copy of an image
+type Thumbnail struct {
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// Image holds the dimensions of an uploaded image and its thumbnail
+type Image struct {
+	Width     int
+	Height    int
+	Thumbnail Thumbnail
+}
+
+// ProcessImage decodes an image and renders a thumbnail that fits into ThumbnailSize.
+// PNG and GIF thumbnails stay PNG to keep transparency; everything else becomes JPEG.
+func ProcessImage(data []byte) (*Image, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxImagePixels {
+		return nil, ErrInvalidImage
+	}
+
+	var src image.Image
+	switch format {
+	case "png":
+		src, err = png.Decode(bytes.NewReader(data))
+	case "jpeg":
+		src, err = jpeg.Decode(bytes.NewReader(data))
+	case "gif":
+		src, err = gif.Decode(bytes.NewReader(data)) // First frame only
+	default:
+		return nil, ErrInvalidImage
+	}
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	thumb := resize(src, ThumbnailSize)
+	var buf bytes.Buffer
+	contentType := "image/jpeg"
+	if format == "png" || format == "gif" {
+		contentType = "image/png"
+		err = png.Encode(&buf, thumb)
+	} else {
+		err = jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: 85})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Image{
+		Width:  cfg.Width,
+		Height: cfg.Height,
+		Thumbnail: Thumbnail{
+			Data:        buf.Bytes(),
+			ContentType: contentType,
+			Width:       thumb.Bounds().Dx(),
+			Height:      thumb.Bounds().Dy(),
+		},
+	}, nil
+}
+
+// resize scales an image down so its longest side is at most maxSide, averaging the source
+// pixels covered by each target pixel. Smaller images are copied unchanged. Pixels are read
+// straight from the decoded image, so only the small target buffer is allocated.
+func resize(src image.Image, maxSide int) *image.RGBA {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	if srcW <= maxSide && srcH <= maxSide {
+		dst := image.NewRGBA(image.Rect(0, 0, srcW, srcH))
+		draw.Draw(dst, dst.Bounds(), src, bounds.Min, draw.Src)
+		return dst
+	}
+
+	dstW, dstH := maxSide, maxSide
+	if srcW > srcH {
+		dstH = max(1, srcH*maxSide/srcW)
+	} else {
+		dstW = max(1, srcW*maxSide/srcH)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		y0, y1 := y*srcH/dstH, max((y+1)*srcH/dstH, y*srcH/dstH+1)
+		for x := 0; x < dstW; x++ {
+			x0, x1 := x*srcW/dstW, max((x+1)*srcW/dstW, x*srcW/dstW+1)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					// RGBA returns alpha-premultiplied 16-bit channels, matching image.RGBA's premultiplied bytes
+					pr, pg, pb, pa := src.At(bounds.Min.X+sx, bounds.Min.Y+sy).RGBA()
+					r += uint64(pr)
+					g += uint64(pg)
+					b += uint64(pb)
+					a += uint64(pa)
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n >> 8)
+			dst.Pix[i+1] = uint8(g / n >> 8)
+			dst.Pix[i+2] = uint8(b / n >> 8)
+			dst.Pix[i+3] = uint8(a / n >> 8)
+		}
+	}
+	return dst
+}
@@ -6,10 +6,18 @@ import (
 	"sync"
 	"time"
 
+	"monad-devhub-be/internal/utils"
+
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// Token audiences; admin and builder tokens are also signed with different secrets
+const (
+	AdminAudience   = "monad-devhub-admin"
+	BuilderAudience = "monad-devhub-builder"
+)
+
 // Logger returns a gin middleware for logging
 func Logger() gin.HandlerFunc {
 	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
@@ -104,69 +112,182 @@ func RateLimit(requestsPerMinute int) gin.HandlerFunc {
 
 // Claims represents JWT token claims
 type Claims struct {
-	Role string `json:"role"`
+	Role     string `json:"role"`
+	Username string `json:"username"`
+	UserID   uint   `json:"uid,omitempty"` // AdminUser ID, or BuilderAccount ID for builders; zero for the environment fallback admin
 	jwt.RegisteredClaims
 }
 
-// JWTAuth returns a gin middleware for JWT authentication
+// JWTAuth returns a gin middleware that requires a valid token issued at admin login, for admins and judges alike
 func JWTAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Get token from Authorization header
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"success": false,
-				"error": gin.H{
-					"code":    "NO_TOKEN",
-					"message": "No authorization token provided",
-				},
-			})
-			c.Abort()
+		if !authenticate(c, ValidateAdminJWT) {
 			return
 		}
+		c.Next()
+	}
+}
 
-		// Remove "Bearer " prefix
-		tokenString := authHeader
-		if len(authHeader) > 7 && authHeader[:7] == "Bearer " {
-			tokenString = authHeader[7:]
-		}
+// authenticate validates the bearer token and stores its claims, aborting the request if it is missing or invalid
+func authenticate(c *gin.Context, validate func(tokenString string) (*Claims, bool)) bool {
+	// Get token from Authorization header
+	authHeader := c.GetHeader("Authorization")
+	if authHeader == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "NO_TOKEN",
+				"message": "No authorization token provided",
+			},
+		})
+		c.Abort()
+		return false
+	}
 
-		// Validate token
-		if !validateJWT(tokenString) {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"success": false,
-				"error": gin.H{
-					"code":    "INVALID_TOKEN",
-					"message": "Token is invalid or expired",
-				},
-			})
-			c.Abort()
+	// Validate token
+	claims, ok := validate(bearerToken(authHeader))
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "INVALID_TOKEN",
+				"message": "Token is invalid or expired",
+			},
+		})
+		c.Abort()
+		return false
+	}
+
+	setClaims(c, claims)
+	return true
+}
+
+// AdminAuth returns a gin middleware that requires a valid token with the admin role
+func AdminAuth() gin.HandlerFunc {
+	return RoleAuth("admin")
+}
+
+// RoleAuth returns a gin middleware that requires a valid token carrying one of the given roles
+func RoleAuth(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !authenticate(c, validateJWT) {
 			return
 		}
 
+		role := c.GetString("role")
+		for _, allowed := range roles {
+			if role == allowed {
+				c.Next()
+				return
+			}
+		}
+
+		c.JSON(http.StatusForbidden, gin.H{
+			"success": false,
+			"error": gin.H{
+				"code":    "FORBIDDEN",
+				"message": "Your account is not allowed to access this resource",
+			},
+		})
+		c.Abort()
+	}
+}
+
+// OptionalJWTAuth returns a gin middleware that records the caller's claims when a
+// valid token is present, but lets anonymous requests through
+func OptionalJWTAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if authHeader := c.GetHeader("Authorization"); authHeader != "" {
+			if claims, ok := validateJWT(bearerToken(authHeader)); ok {
+				setClaims(c, claims)
+			}
+		}
 		c.Next()
 	}
 }
 
-// validateJWT validates a JWT token
-func validateJWT(tokenString string) bool {
+// IsAdmin reports whether the request was authenticated with an admin token
+func IsAdmin(c *gin.Context) bool {
+	return c.GetString("role") == "admin"
+}
+
+// CurrentUserID returns the AdminUser ID of the authenticated caller, or zero
+func CurrentUserID(c *gin.Context) uint {
+	return c.GetUint("userID")
+}
+
+// CurrentBuilderAddress returns the lowercase wallet address of a signed-in builder, or ""
+func CurrentBuilderAddress(c *gin.Context) string {
+	if c.GetString("role") != "builder" {
+		return ""
+	}
+	return c.GetString("username")
+}
+
+// setClaims stores the authenticated claims on the request context
+func setClaims(c *gin.Context, claims *Claims) {
+	c.Set("role", claims.Role)
+	c.Set("username", claims.Username)
+	c.Set("userID", claims.UserID)
+}
+
+// bearerToken removes the "Bearer " prefix from an Authorization header
+func bearerToken(authHeader string) string {
+	if len(authHeader) > 7 && authHeader[:7] == "Bearer " {
+		return authHeader[7:]
+	}
+	return authHeader
+}
+
+// JWTSecret returns the secret admin and judge tokens are signed with
+func JWTSecret() string {
 	jwtSecret := os.Getenv("JWT_SECRET")
 	if jwtSecret == "" {
 		jwtSecret = "your-super-secret-jwt-key"
 	}
+	return jwtSecret
+}
 
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(jwtSecret), nil
-	})
+// BuilderJWTSecret returns the secret builder session tokens are signed with: BUILDER_JWT_SECRET,
+// or a key derived from JWT_SECRET so that the two never coincide
+func BuilderJWTSecret() string {
+	if secret := os.Getenv("BUILDER_JWT_SECRET"); secret != "" {
+		return secret
+	}
+	return utils.KeyedHash(JWTSecret(), "builder-session")
+}
 
-	if err != nil {
-		return false
+// ValidateAdminJWT validates a token issued at admin login and returns its claims.
+// Its role is "admin" or "judge"; callers check which one they need
+func ValidateAdminJWT(tokenString string) (*Claims, bool) {
+	claims, ok := parseJWT(tokenString, JWTSecret(), AdminAudience)
+	if !ok || claims.Role == "builder" {
+		return nil, false
+	}
+	return claims, true
+}
+
+// validateJWT validates an admin or builder token and returns its claims
+func validateJWT(tokenString string) (*Claims, bool) {
+	if claims, ok := ValidateAdminJWT(tokenString); ok {
+		return claims, true
 	}
+	claims, ok := parseJWT(tokenString, BuilderJWTSecret(), BuilderAudience)
+	if !ok || claims.Role != "builder" {
+		return nil, false
+	}
+	return claims, true
+}
 
-	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		// Check if token is expired
-		return claims.ExpiresAt.After(time.Now())
+// parseJWT verifies a token's signature, expiry and audience and returns its claims
+func parseJWT(tokenString, secret, audience string) (*Claims, bool) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithAudience(audience), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, false
 	}
 
-	return false
+	claims, ok := token.Claims.(*Claims)
+	return claims, ok && token.Valid
 }
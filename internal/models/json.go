@@ -0,0 +1,119 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// JSON is a raw JSON document stored in a jsonb column, e.g. an event's form schema.
+// Keeping the raw bytes preserves property order, which forms render in.
+type JSON json.RawMessage
+
+// Scan implements sql.Scanner
+func (j *JSON) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*j = nil
+	case []byte:
+		*j = append(JSON(nil), v...)
+	case string:
+		*j = JSON(v)
+	default:
+		return fmt.Errorf("cannot scan %T into JSON", value)
+	}
+	return nil
+}
+
+// Value implements driver.Valuer; an empty document is stored as NULL
+func (j JSON) Value() (driver.Value, error) {
+	if len(j) == 0 {
+		return nil, nil
+	}
+	return string(j), nil
+}
+
+// MarshalJSON embeds the document as is
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if len(j) == 0 {
+		return []byte("null"), nil
+	}
+	return j, nil
+}
+
+// UnmarshalJSON stores a copy of the document; null becomes empty
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*j = nil
+		return nil
+	}
+	*j = append(JSON(nil), data...)
+	return nil
+}
+
+// ExtraFields holds the values of an event's custom submission fields, stored as a jsonb object
+type ExtraFields map[string]interface{}
+
+// Scan implements sql.Scanner
+func (e *ExtraFields) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*e = nil
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into ExtraFields", value)
+	}
+	return json.Unmarshal(data, e)
+}
+
+// Value implements driver.Valuer; no extra fields are stored as NULL
+func (e ExtraFields) Value() (driver.Value, error) {
+	if len(e) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// FieldChange is the value of a project field before and after a proposed change
+type FieldChange struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// ChangeSet maps project fields (by their JSON names) to proposed changes, stored as a jsonb object
+type ChangeSet map[string]FieldChange
+
+// Scan implements sql.Scanner
+func (c *ChangeSet) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*c = nil
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan %T into ChangeSet", value)
+	}
+	return json.Unmarshal(data, c)
+}
+
+// Value implements driver.Valuer
+func (c ChangeSet) Value() (driver.Value, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
@@ -9,34 +9,196 @@ import (
 
 // Project represents an approved project in the database
 type Project struct {
+	ID             uint              `json:"id" gorm:"primaryKey"`
+	Name           string            `json:"name" gorm:"uniqueIndex;not null"`
+	Logo           string            `json:"logo"`
+	LogoThumbnail  string            `json:"logoThumbnail,omitempty" gorm:"column:logo_thumbnail"` // Set when the logo was uploaded
+	Description    string            `json:"description" gorm:"not null"`
+	Categories     pq.StringArray    `json:"categories" gorm:"type:text[]"`
+	Event          string            `json:"event" gorm:"not null"` // Event name, denormalized for filtering and display
+	EventID        *uint             `json:"eventId,omitempty" gorm:"column:event_id;index"`
+	EventRecord    *Event            `json:"-" gorm:"foreignKey:EventID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Likes          int               `json:"likes" gorm:"default:0"`                 // LegacyLikes plus one per ProjectLike
+	LegacyLikes    int               `json:"-" gorm:"column:legacy_likes;default:0"` // Likes counted before likes were recorded per voter
+	LikedByMe      bool              `json:"likedByMe" gorm:"-"`                     // Whether the caller has liked the project
+	Highlight      *SearchHighlight  `json:"highlight,omitempty" gorm:"-"`           // Matched search terms, only set in search results
+	Comments       int               `json:"comments" gorm:"default:0"`
+	HowToPlay      string            `json:"howToPlay" gorm:"column:how_to_play;not null"`
+	PlayURL        string            `json:"playUrl" gorm:"column:play_url;not null"`
+	GithubURL      *string           `json:"github,omitempty" gorm:"column:github_url"`
+	WebsiteURL     *string           `json:"website,omitempty" gorm:"column:website_url"`
+	ExtraFields    ExtraFields       `json:"-" gorm:"column:extra_fields;type:jsonb"`                            // Values of the event's custom fields, including private answers
+	PublicFields   ExtraFields       `json:"extraFields,omitempty" gorm:"column:public_extra_fields;type:jsonb"` // The extra fields the event marks public
+	TeamMembers    []TeamMember      `json:"team" gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+	Award          string            `json:"award" gorm:"-"` // Name of the primary award, kept for clients that predate Awards
+	Awards         []ProjectAward    `json:"awards" gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+	Media          []ProjectMedia    `json:"media,omitempty" gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`     // Gallery, only loaded for single projects
+	Contracts      []ProjectContract `json:"contracts,omitempty" gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"` // Deployed contracts, only loaded for single projects
+	SubmissionID   *string           `json:"submissionId,omitempty" gorm:"column:submission_id;uniqueIndex"`
+	PublishAt      *time.Time        `json:"publishAt,omitempty" gorm:"column:publish_at;index"`                                       // Scheduled reveal time for approved projects
+	PublishedAt    *time.Time        `json:"publishedAt,omitempty" gorm:"column:published_at;index"`                                   // Nil while the project is hidden from the public
+	ExternalSource *string           `json:"externalSource,omitempty" gorm:"column:external_source;uniqueIndex:idx_projects_external"` // Platform the project was imported from
+	ExternalID     *string           `json:"externalId,omitempty" gorm:"column:external_id;uniqueIndex:idx_projects_external"`         // Entry ID on that platform
+	OwnerAddress   *string           `json:"ownerAddress,omitempty" gorm:"column:owner_address;index"`                                 // Lowercase wallet address of the builder account that owns the project
+	Featured       bool              `json:"featured" gorm:"default:false;index"`                                                      // Shown in the featured carousel while inside its window
+	FeaturedOrder  int               `json:"featuredOrder,omitempty" gorm:"column:featured_order;default:0"`                           // Position in the carousel, lowest first
+	FeaturedFrom   *time.Time        `json:"featuredFrom,omitempty" gorm:"column:featured_from"`                                       // Nil means featured as soon as flagged
+	FeaturedUntil  *time.Time        `json:"featuredUntil,omitempty" gorm:"column:featured_until"`                                     // Nil means featured until unflagged
+	CreatedAt      time.Time         `json:"createdAt"`
+	UpdatedAt      time.Time         `json:"updatedAt"`
+	DeletedAt      gorm.DeletedAt    `json:"-" gorm:"index"`
+}
+
+// AfterFind sets Award from the awards loaded with the project
+func (p *Project) AfterFind(tx *gorm.DB) error {
+	p.Award = p.PrimaryAward()
+	return nil
+}
+
+// PrimaryAward returns the name of the project's best-ranked award, the earliest granted one among equals.
+// It is empty when the project has no awards or they were not loaded
+func (p *Project) PrimaryAward() string {
+	var primary *ProjectAward
+	for i := range p.Awards {
+		grant := &p.Awards[i]
+		if grant.Award == nil {
+			continue
+		}
+		if primary == nil || rankBefore(grant.Award.Rank, primary.Award.Rank) ||
+			(sameRank(grant.Award.Rank, primary.Award.Rank) && grant.GrantedAt.Before(primary.GrantedAt)) {
+			primary = grant
+		}
+	}
+	if primary == nil {
+		return ""
+	}
+	return primary.Award.Name
+}
+
+// rankBefore reports whether rank a places ahead of rank b; unranked awards come last
+func rankBefore(a, b *int) bool {
+	return a != nil && (b == nil || *a < *b)
+}
+
+// sameRank reports whether two ranks are equal, treating two unranked awards as equal
+func sameRank(a, b *int) bool {
+	return (a == nil && b == nil) || (a != nil && b != nil && *a == *b)
+}
+
+// SearchHighlight is a project's name and a snippet of its description with the matched search terms
+// wrapped in <mark> tags; all other text is HTML-escaped
+type SearchHighlight struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Event represents a mission, hackathon or other program that projects are submitted to
+type Event struct {
+	ID                 uint       `json:"id" gorm:"primaryKey"`
+	Slug               string     `json:"slug" gorm:"uniqueIndex;not null"`
+	Name               string     `json:"name" gorm:"uniqueIndex;not null"`
+	Description        string     `json:"description"`
+	StartDate          *time.Time `json:"startDate,omitempty" gorm:"column:start_date"`
+	EndDate            *time.Time `json:"endDate,omitempty" gorm:"column:end_date"`
+	SubmissionsOpenAt  *time.Time `json:"submissionsOpenAt,omitempty" gorm:"column:submissions_open_at"`   // Nil means open since creation
+	SubmissionsCloseAt *time.Time `json:"submissionsCloseAt,omitempty" gorm:"column:submissions_close_at"` // Nil means no deadline
+	Status             string     `json:"status" gorm:"default:'active';not null;index"`                   // draft, active, closed or archived
+	FormSchema         JSON       `json:"formSchema,omitempty" gorm:"column:form_schema;type:jsonb"`       // JSON Schema of the event's extra submission fields
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          time.Time  `json:"updatedAt"`
+}
+
+// Category represents a project category; categories form a hierarchy through ParentID
+type Category struct {
 	ID           uint           `json:"id" gorm:"primaryKey"`
 	Name         string         `json:"name" gorm:"uniqueIndex;not null"`
-	Logo         string         `json:"logo"`
-	Description  string         `json:"description" gorm:"not null"`
-	Categories   pq.StringArray `json:"categories" gorm:"type:text[]"`
-	Event        string         `json:"event" gorm:"not null"`
-	Award        string         `json:"award"`
-	Likes        int            `json:"likes" gorm:"default:0"`
-	Comments     int            `json:"comments" gorm:"default:0"`
-	HowToPlay    string         `json:"howToPlay" gorm:"column:how_to_play;not null"`
-	PlayURL      string         `json:"playUrl" gorm:"column:play_url;not null"`
-	GithubURL    *string        `json:"github,omitempty" gorm:"column:github_url"`
-	WebsiteURL   *string        `json:"website,omitempty" gorm:"column:website_url"`
-	TeamMembers  []TeamMember   `json:"team" gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
-	SubmissionID *string        `json:"submissionId,omitempty" gorm:"column:submission_id;uniqueIndex"`
+	Slug         string         `json:"slug" gorm:"uniqueIndex;not null"`
+	ParentID     *uint          `json:"parentId,omitempty" gorm:"column:parent_id;index"`
+	Parent       *Category      `json:"-" gorm:"foreignKey:ParentID;constraint:OnDelete:RESTRICT"`
+	Icon         string         `json:"icon"`
+	DisplayOrder int            `json:"displayOrder" gorm:"column:display_order;default:0"`
+	Aliases      pq.StringArray `json:"aliases" gorm:"type:text[]"` // Alternative spellings accepted on submission, e.g. "Stablecoin"
 	CreatedAt    time.Time      `json:"createdAt"`
 	UpdatedAt    time.Time      `json:"updatedAt"`
-	DeletedAt    gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+// Award represents a prize that can be granted to projects, optionally scoped to an event track
+type Award struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	Name          string    `json:"name" gorm:"not null;uniqueIndex:idx_awards_event_track_name"`
+	Event         string    `json:"event,omitempty" gorm:"->;-:migration"` // Event name, read from the joined event; see repository.awardEventScope
+	EventID       *uint     `json:"eventId,omitempty" gorm:"column:event_id;uniqueIndex:idx_awards_event_track_name"`
+	EventRecord   *Event    `json:"-" gorm:"foreignKey:EventID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Track         string    `json:"track,omitempty" gorm:"not null;default:'';uniqueIndex:idx_awards_event_track_name"` // Prize track within the event, e.g. "Best DeFi"
+	Rank          *int      `json:"rank,omitempty"`                                                                     // Placement within the track; nil for unranked prizes
+	PrizeAmount   *float64  `json:"prizeAmount,omitempty" gorm:"column:prize_amount;type:numeric(20,2)"`
+	PrizeCurrency string    `json:"prizeCurrency,omitempty" gorm:"column:prize_currency"` // e.g. "USD" or "MON"
+	Sponsor       string    `json:"sponsor,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+// ProjectAward records that an award was granted to a project
+type ProjectAward struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	ProjectID uint      `json:"projectId" gorm:"not null;uniqueIndex:idx_project_awards_project_award"`
+	AwardID   uint      `json:"awardId" gorm:"not null;uniqueIndex:idx_project_awards_project_award;index"`
+	Award     *Award    `json:"award,omitempty" gorm:"foreignKey:AwardID;constraint:OnDelete:RESTRICT"`
+	Note      string    `json:"note,omitempty"`
+	GrantedAt time.Time `json:"grantedAt"`
 }
 
 // TeamMember represents a project team member
 type TeamMember struct {
-	ID        uint      `json:"id" gorm:"primaryKey"`
-	ProjectID uint      `json:"projectId" gorm:"not null"`
-	Name      string    `json:"name" gorm:"not null"`
-	Twitter   string    `json:"twitter"`
-	Image     string    `json:"image"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID             uint      `json:"id" gorm:"primaryKey"`
+	ProjectID      uint      `json:"projectId" gorm:"not null"`
+	BuilderID      *uint     `json:"builderId,omitempty" gorm:"column:builder_id;index"`
+	Builder        *Builder  `json:"-" gorm:"foreignKey:BuilderID;constraint:OnDelete:SET NULL"`
+	Name           string    `json:"name" gorm:"not null"`
+	Twitter        string    `json:"twitter"`
+	Image          string    `json:"image"`
+	ImageThumbnail string    `json:"imageThumbnail,omitempty" gorm:"column:image_thumbnail"` // Set when the photo was uploaded
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// Builder is a person behind one or more projects, identified across projects by their twitter handle
+type Builder struct {
+	ID        uint           `json:"id" gorm:"primaryKey"`
+	Handle    string         `json:"handle" gorm:"uniqueIndex;not null"` // Normalized twitter handle: lowercase, without "@"
+	Name      string         `json:"name"`
+	Image     string         `json:"image"`
+	Aliases   pq.StringArray `json:"aliases" gorm:"type:text[]"` // Handles of builders merged into this one
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+}
+
+// ProjectMedia is a screenshot or video in a project's gallery
+type ProjectMedia struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	ProjectID    uint      `json:"projectId" gorm:"not null;index"`
+	Kind         string    `json:"kind" gorm:"not null"` // image or video
+	URL          string    `json:"url" gorm:"not null"`
+	ThumbnailURL string    `json:"thumbnailUrl,omitempty" gorm:"column:thumbnail_url"`
+	ContentType  string    `json:"contentType" gorm:"column:content_type"`
+	Size         int64     `json:"size"`
+	Width        int       `json:"width,omitempty"`
+	Height       int       `json:"height,omitempty"`
+	Caption      string    `json:"caption"`
+	DisplayOrder int       `json:"displayOrder" gorm:"column:display_order;default:0"`
+	StorageKey   string    `json:"-" gorm:"column:storage_key"`
+	ThumbnailKey string    `json:"-" gorm:"column:thumbnail_key"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// Upload is an image uploaded for a submission. Uploads that nothing references once UploadTTL has passed
+// are deleted with their files; referenced ones are marked claimed and kept
+type Upload struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	URL          string     `json:"url" gorm:"not null;index"`
+	StorageKey   string     `json:"-" gorm:"column:storage_key;not null;uniqueIndex"`
+	ThumbnailKey string     `json:"-" gorm:"column:thumbnail_key"`
+	ClaimedAt    *time.Time `json:"claimedAt,omitempty" gorm:"column:claimed_at"`
+	CreatedAt    time.Time  `json:"createdAt" gorm:"index"`
 }
 
 // Submission represents a project submission awaiting review
@@ -45,7 +207,9 @@ type Submission struct {
 	ProjectName       string         `json:"projectName" gorm:"column:project_name;not null"`
 	Description       string         `json:"description" gorm:"not null"`
 	PhotoLink         string         `json:"photoLink" gorm:"column:photo_link"`
-	Event             string         `json:"event" gorm:"not null"`
+	Event             string         `json:"event" gorm:"not null"` // Event name, denormalized for filtering and display
+	EventID           *uint          `json:"eventId,omitempty" gorm:"column:event_id;index"`
+	EventRecord       *Event         `json:"-" gorm:"foreignKey:EventID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
 	Categories        pq.StringArray `json:"categories" gorm:"type:text[]"`
 	TeamMembers       string         `json:"teamMembers" gorm:"column:team_members;type:jsonb"` // Store as JSON
 	GithubLink        *string        `json:"githubLink,omitempty" gorm:"column:github_link"`
@@ -53,6 +217,8 @@ type Submission struct {
 	PlayLink          string         `json:"playLink" gorm:"column:play_link;not null"`
 	HowToPlay         string         `json:"howToPlay" gorm:"column:how_to_play;not null"`
 	AdditionalNotes   *string        `json:"additionalNotes,omitempty" gorm:"column:additional_notes"`
+	ExtraFields       ExtraFields    `json:"extraFields,omitempty" gorm:"column:extra_fields;type:jsonb"`              // Values of the event's custom fields
+	ContractAddresses pq.StringArray `json:"contractAddresses,omitempty" gorm:"column:contract_addresses;type:text[]"` // Declared deployments, verified by admins after approval
 	Status            string         `json:"status" gorm:"default:'pending'"`
 	ReviewerID        *uint          `json:"reviewerId,omitempty" gorm:"column:reviewer_id"`
 	Feedback          *string        `json:"feedback,omitempty"`
@@ -63,6 +229,10 @@ type Submission struct {
 	PublishedAt       *time.Time     `json:"publishedAt,omitempty" gorm:"column:published_at"`
 	ApprovedProjectID *uint          `json:"approvedProjectId,omitempty" gorm:"column:approved_project_id"`
 	ApprovedProject   *Project       `json:"project,omitempty" gorm:"foreignKey:ApprovedProjectID"`
+	AccessTokenHash   string         `json:"-" gorm:"column:access_token_hash"`                                                           // SHA-256 of the submitter's secret token
+	ExternalSource    *string        `json:"externalSource,omitempty" gorm:"column:external_source;uniqueIndex:idx_submissions_external"` // Platform the submission was imported from
+	ExternalID        *string        `json:"externalId,omitempty" gorm:"column:external_id;uniqueIndex:idx_submissions_external"`         // Entry ID on that platform
+	OwnerAddress      *string        `json:"ownerAddress,omitempty" gorm:"column:owner_address;index"`                                    // Builder account that submitted, becomes the project's owner
 	CreatedAt         time.Time      `json:"createdAt"`
 	UpdatedAt         time.Time      `json:"updatedAt"`
 }
@@ -113,6 +283,68 @@ type ContractStats struct {
 	LastUpdated   time.Time `json:"lastUpdated" gorm:"column:last_updated"`
 }
 
+// ProjectContract is a contract address a project declared as deployed.
+// Only verified declarations are linked to a Contract and count towards the project's on-chain activity.
+type ProjectContract struct {
+	ID              uint       `json:"id" gorm:"primaryKey"`
+	ProjectID       uint       `json:"projectId" gorm:"not null;uniqueIndex:idx_project_contracts_project_address"`
+	Address         string     `json:"address" gorm:"not null;uniqueIndex:idx_project_contracts_project_address;index"` // Lowercase 0x-prefixed address
+	Label           string     `json:"label"`                                                                           // e.g. "Game contract" or "NFT collection"
+	Status          string     `json:"status" gorm:"default:'pending';not null;index"`                                  // pending, verified or rejected
+	ContractID      *string    `json:"contractId,omitempty" gorm:"column:contract_id;index"`                            // Set once verified
+	Contract        *Contract  `json:"contract,omitempty" gorm:"foreignKey:ContractID;constraint:OnDelete:SET NULL"`
+	RejectionReason *string    `json:"rejectionReason,omitempty" gorm:"column:rejection_reason"`
+	VerifiedBy      *uint      `json:"verifiedBy,omitempty" gorm:"column:verified_by"`
+	VerifiedAt      *time.Time `json:"verifiedAt,omitempty" gorm:"column:verified_at"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+// ProjectLike records that one voter liked a project. Voters are identified by an account,
+// a signed anonymous device token, or their IP address plus a client fingerprint.
+type ProjectLike struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	ProjectID uint      `json:"projectId" gorm:"not null;uniqueIndex:idx_project_likes_project_voter"`
+	Project   *Project  `json:"-" gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+	VoterKey  string    `json:"-" gorm:"column:voter_key;not null;uniqueIndex:idx_project_likes_project_voter;index"`
+	VoterType string    `json:"voterType" gorm:"column:voter_type;not null"` // account, device or fingerprint
+	IPHash    string    `json:"-" gorm:"column:ip_hash;index"`               // Keyed hash of the voter's IP address
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Comment is a comment on a project. Replies point at their parent and at the top-level comment of their thread.
+type Comment struct {
+	ID             uint      `json:"id" gorm:"primaryKey"`
+	ProjectID      uint      `json:"projectId" gorm:"not null;index"`
+	Project        *Project  `json:"-" gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+	ParentID       *uint     `json:"parentId,omitempty" gorm:"column:parent_id;index"`
+	RootID         *uint     `json:"rootId,omitempty" gorm:"column:root_id;index"` // Nil for top-level comments
+	Depth          int       `json:"depth" gorm:"not null;default:0"`
+	AuthorName     string    `json:"authorName" gorm:"column:author_name;not null"`
+	AuthorKey      string    `json:"-" gorm:"column:author_key;not null;index"` // SHA-256 of the author's comment token, or "builder:<address>" for signed-in builders
+	IPHash         string    `json:"-" gorm:"column:ip_hash;index"`             // Keyed hash of the author's IP address
+	Body           string    `json:"body" gorm:"not null"`
+	Status         string    `json:"status" gorm:"default:'visible';not null;index"` // visible, hidden or deleted
+	Pinned         bool      `json:"pinned" gorm:"default:false"`
+	ModerationNote *string   `json:"moderationNote,omitempty" gorm:"column:moderation_note"`
+	Replies        []Comment `json:"replies,omitempty" gorm:"-"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+// CommentBan stops an author from commenting; it matches their comment token and optionally their IP address
+type CommentBan struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	AuthorKey  string     `json:"-" gorm:"column:author_key;not null;index"`
+	IPHash     string     `json:"-" gorm:"column:ip_hash;index"` // Empty when the IP address is not banned
+	AuthorName string     `json:"authorName" gorm:"column:author_name"`
+	CommentID  *uint      `json:"commentId,omitempty" gorm:"column:comment_id"` // Comment the ban was issued from
+	Reason     string     `json:"reason"`
+	BannedBy   *uint      `json:"bannedBy,omitempty" gorm:"column:banned_by"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty" gorm:"column:expires_at"` // Nil for permanent bans
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
 // TeamMemberInput represents team member data from submission form
 type TeamMemberInput struct {
 	Name    string `json:"name" binding:"required"`
@@ -123,8 +355,125 @@ type TeamMemberInput struct {
 type AdminUser struct {
 	ID        uint      `json:"id" gorm:"primaryKey"`
 	Username  string    `json:"username" gorm:"uniqueIndex;not null"`
-	Password  string    `json:"password" gorm:"not null"` // In production, this should be hashed
+	Password  string    `json:"password" gorm:"not null"`             // In production, this should be hashed
+	Role      string    `json:"role" gorm:"default:'admin';not null"` // "admin" or "judge"
 	IsActive  bool      `json:"isActive" gorm:"default:true"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
+
+// ProjectChangeRequest is an edit to a published project proposed by its owner. Low-risk fields
+// may be auto-approved; everything else waits for a reviewer.
+type ProjectChangeRequest struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	ProjectID    uint       `json:"projectId" gorm:"not null;index"`
+	Project      *Project   `json:"project,omitempty" gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+	RequestedBy  string     `json:"requestedBy" gorm:"column:requested_by;not null;index"` // Lowercase address of the proposing builder
+	Changes      ChangeSet  `json:"changes" gorm:"type:jsonb;not null"`
+	Note         string     `json:"note,omitempty"`                                 // Context for the reviewer
+	Status       string     `json:"status" gorm:"default:'pending';not null;index"` // pending, approved, rejected or withdrawn
+	AutoApproved bool       `json:"autoApproved" gorm:"column:auto_approved;default:false"`
+	ReviewerID   *uint      `json:"reviewerId,omitempty" gorm:"column:reviewer_id"`
+	ReviewNote   *string    `json:"reviewNote,omitempty" gorm:"column:review_note"` // Rejection reason or approval remark
+	ReviewedAt   *time.Time `json:"reviewedAt,omitempty" gorm:"column:reviewed_at"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
+
+// ProjectChangeLog records one field of a project changing, and who changed it
+type ProjectChangeLog struct {
+	ID              uint      `json:"id" gorm:"primaryKey"`
+	ProjectID       uint      `json:"projectId" gorm:"not null;index"`
+	Project         *Project  `json:"-" gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+	ChangeRequestID *uint     `json:"changeRequestId,omitempty" gorm:"column:change_request_id;index"`
+	Field           string    `json:"field" gorm:"not null"`
+	OldValue        JSON      `json:"oldValue" gorm:"column:old_value;type:jsonb"`
+	NewValue        JSON      `json:"newValue" gorm:"column:new_value;type:jsonb"`
+	ChangedBy       string    `json:"changedBy" gorm:"column:changed_by;not null"` // "builder:<address>" or "admin:<id>"
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+// Collection is a curated, ordered list of projects, e.g. "Best of Mission 3"
+type Collection struct {
+	ID           uint                `json:"id" gorm:"primaryKey"`
+	Slug         string              `json:"slug" gorm:"uniqueIndex;not null"`
+	Title        string              `json:"title" gorm:"not null"`
+	Description  string              `json:"description"`
+	Status       string              `json:"status" gorm:"default:'draft';not null;index"`       // draft or published
+	DisplayOrder int                 `json:"displayOrder" gorm:"column:display_order;default:0"` // Position among collections, lowest first
+	Items        []CollectionProject `json:"-" gorm:"foreignKey:CollectionID;constraint:OnDelete:CASCADE"`
+	ProjectCount int                 `json:"projectCount" gorm:"-"`
+	Projects     []Project           `json:"projects,omitempty" gorm:"-"` // Only loaded for single collections
+	CreatedAt    time.Time           `json:"createdAt"`
+	UpdatedAt    time.Time           `json:"updatedAt"`
+}
+
+// CollectionProject places a project at a position in a collection
+type CollectionProject struct {
+	ID           uint     `json:"id" gorm:"primaryKey"`
+	CollectionID uint     `json:"collectionId" gorm:"not null;uniqueIndex:idx_collection_projects_collection_project"`
+	ProjectID    uint     `json:"projectId" gorm:"not null;uniqueIndex:idx_collection_projects_collection_project;index"`
+	Project      *Project `json:"-" gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE"`
+	Position     int      `json:"position" gorm:"not null;default:0"`
+}
+
+// BuilderAccount is a builder who signed in with their Ethereum wallet (EIP-4361)
+type BuilderAccount struct {
+	ID          uint       `json:"id" gorm:"primaryKey"`
+	Address     string     `json:"address" gorm:"uniqueIndex;not null"` // Lowercase wallet address
+	LastLoginAt *time.Time `json:"lastLoginAt,omitempty" gorm:"column:last_login_at"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// SiweNonce is a single-use nonce handed out for a Sign-In with Ethereum message
+type SiweNonce struct {
+	Nonce     string    `json:"nonce" gorm:"primaryKey"`
+	ExpiresAt time.Time `json:"expiresAt" gorm:"column:expires_at;not null;index"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// SubmissionDraft represents a partially completed submission saved for later
+type SubmissionDraft struct {
+	ID                    string    `json:"id" gorm:"primaryKey"`            // Draft token like DRAFT-xxx
+	Data                  string    `json:"data" gorm:"type:jsonb;not null"` // Partial submission payload as JSON
+	FinalizedSubmissionID *string   `json:"finalizedSubmissionId,omitempty" gorm:"column:finalized_submission_id"`
+	ExpiresAt             time.Time `json:"expiresAt" gorm:"column:expires_at;index"`
+	CreatedAt             time.Time `json:"createdAt"`
+	UpdatedAt             time.Time `json:"updatedAt"`
+}
+
+// JudgingCriterion is one weighted line of an event's judging rubric
+type JudgingCriterion struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	EventID      uint      `json:"eventId" gorm:"column:event_id;not null;index"`
+	EventRecord  *Event    `json:"-" gorm:"foreignKey:EventID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Name         string    `json:"name" gorm:"not null"`
+	Description  string    `json:"description"`
+	Weight       float64   `json:"weight" gorm:"not null;default:1"`
+	MaxScore     int       `json:"maxScore" gorm:"column:max_score;not null;default:10"`
+	DisplayOrder int       `json:"displayOrder" gorm:"column:display_order;default:0"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// JudgeAssignment allows a judge account to score the projects of an event
+type JudgeAssignment struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	JudgeID     uint      `json:"judgeId" gorm:"column:judge_id;not null;uniqueIndex:idx_judge_assignments_judge_event_id"`
+	EventID     uint      `json:"eventId" gorm:"column:event_id;not null;uniqueIndex:idx_judge_assignments_judge_event_id"`
+	EventRecord *Event    `json:"-" gorm:"foreignKey:EventID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// JudgeScore is a judge's score for one project on one rubric criterion
+type JudgeScore struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	JudgeID     uint      `json:"judgeId" gorm:"column:judge_id;not null;uniqueIndex:idx_judge_scores_unique"`
+	ProjectID   uint      `json:"projectId" gorm:"column:project_id;not null;uniqueIndex:idx_judge_scores_unique;index"`
+	CriterionID uint      `json:"criterionId" gorm:"column:criterion_id;not null;uniqueIndex:idx_judge_scores_unique"`
+	Score       float64   `json:"score" gorm:"not null"`
+	Comment     *string   `json:"comment,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
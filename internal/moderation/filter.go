@@ -0,0 +1,116 @@
+// Package moderation screens user-written text such as project comments before it is stored.
+package moderation
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// defaultBlockedWords is the built-in profanity list; deployments add their own words through configuration
+var defaultBlockedWords = []string{
+	"asshole", "bastard", "bitch", "bollocks", "bullshit", "cock", "cunt", "dickhead",
+	"fag", "faggot", "fuck", "motherfucker", "nigger", "prick", "pussy", "retard",
+	"shit", "slut", "twat", "wanker", "whore",
+}
+
+// wordSuffixes are endings that still count as the blocked word ("fucking", "shits")
+var wordSuffixes = []string{"", "s", "es", "ed", "er", "ers", "ing", "in", "y"}
+
+// leetReplacer undoes common character substitutions before words are compared
+var leetReplacer = strings.NewReplacer(
+	"0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "@", "a", "$", "s",
+)
+
+// linkPattern matches URLs, bare domains ("example.com/path") and markdown or HTML links
+var linkPattern = regexp.MustCompile(`(?i)(\b[a-z][a-z0-9+.-]*://|\bwww\.|\b[a-z0-9-]+(\.[a-z0-9-]+)*\.(com|net|org|io|xyz|app|dev|gg|co|me|ly|link|site|online|finance|fi|exchange|money|top|info|biz|ru|cn|tk)\b|<a\s|\]\()`)
+
+// Verdict is the outcome of screening a text
+type Verdict struct {
+	Allowed bool
+	Reason  string // Why the text was refused; empty when allowed
+}
+
+// Filter refuses text with blocked words and, unless allowed, links
+type Filter struct {
+	blocked    map[string]bool
+	allowLinks bool
+}
+
+// NewFilter creates a filter from the built-in word list plus extra words
+func NewFilter(extraWords []string, allowLinks bool) *Filter {
+	f := &Filter{blocked: make(map[string]bool), allowLinks: allowLinks}
+	for _, word := range append(defaultBlockedWords, extraWords...) {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word != "" {
+			f.blocked[word] = true
+		}
+	}
+	return f
+}
+
+// Check screens a text
+func (f *Filter) Check(text string) Verdict {
+	if !f.allowLinks && linkPattern.MatchString(text) {
+		return Verdict{Reason: "Links are not allowed"}
+	}
+	if f.containsBlockedWord(text) {
+		return Verdict{Reason: "Text contains blocked words"}
+	}
+	return Verdict{Allowed: true}
+}
+
+// containsBlockedWord compares every word of the text, and the text with spacing and
+// punctuation removed between single letters ("f u c k"), against the blocked words
+func (f *Filter) containsBlockedWord(text string) bool {
+	normalized := leetReplacer.Replace(strings.ToLower(text))
+	words := strings.FieldsFunc(normalized, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var spelled strings.Builder
+	for _, word := range words {
+		if f.isBlocked(word) {
+			return true
+		}
+		// Collect runs of single letters that spell a word out
+		if len([]rune(word)) == 1 {
+			spelled.WriteString(word)
+			continue
+		}
+		if f.isBlocked(spelled.String()) {
+			return true
+		}
+		spelled.Reset()
+	}
+	return f.isBlocked(spelled.String())
+}
+
+// isBlocked reports whether a word is a blocked word, allowing common suffixes and stretched letters ("fuuuck")
+func (f *Filter) isBlocked(word string) bool {
+	if word == "" {
+		return false
+	}
+	for _, candidate := range []string{word, squeeze(word)} {
+		for _, suffix := range wordSuffixes {
+			if stem, ok := strings.CutSuffix(candidate, suffix); ok && f.blocked[stem] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// squeeze collapses repeated letters ("shiiit" -> "shit")
+func squeeze(word string) string {
+	var b strings.Builder
+	var last rune
+	for i, r := range word {
+		if i > 0 && r == last {
+			continue
+		}
+		b.WriteRune(r)
+		last = r
+	}
+	return b.String()
+}
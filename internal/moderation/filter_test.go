@@ -0,0 +1,72 @@
+package moderation
+
+import "testing"
+
+func TestCheck(t *testing.T) {
+	const (
+		links   = "Links are not allowed"
+		blocked = "Text contains blocked words"
+	)
+
+	tests := []struct {
+		name       string
+		text       string
+		extra      []string
+		allowLinks bool
+		want       string // Expected reason; empty when the text is allowed
+	}{
+		{name: "plain", text: "Great project, the demo runs smoothly!"},
+		{name: "empty", text: ""},
+		{name: "blocked word", text: "this is shit", want: blocked},
+		{name: "case", text: "FUCK this", want: blocked},
+		{name: "suffix", text: "fucking great", want: blocked},
+		{name: "plural", text: "bunch of pricks", want: blocked},
+		{name: "stretched", text: "shiiiiit", want: blocked},
+		{name: "leet", text: "sh1t happens", want: blocked},
+		{name: "leet symbols", text: "$hit", want: blocked},
+		{name: "spelled out", text: "f u c k you", want: blocked},
+		{name: "spelled with punctuation", text: "f.u.c.k", want: blocked},
+		{name: "spelled with suffix", text: "s h i t t y code", want: blocked},
+		{name: "embedded in a word", text: "Scunthorpe and Essex", want: ""},
+		{name: "sharing a prefix", text: "a classic cocktail in shiitake broth", want: ""},
+		{name: "single letters", text: "a b c d e", want: ""},
+		{name: "extra word", text: "buy SPAMMERS now", extra: []string{" Spam "}, want: blocked},
+		{name: "blank extra word", text: "nothing wrong", extra: []string{"", "  "}, want: ""},
+		{name: "url", text: "see https://example.com", want: links},
+		{name: "custom scheme", text: "open ipfs://bafy", want: links},
+		{name: "www", text: "visit www.example", want: links},
+		{name: "bare domain", text: "free tokens at claim-now.xyz/airdrop", want: links},
+		{name: "subdomain", text: "go to app.monad.gg", want: links},
+		{name: "markdown link", text: "[click](here)", want: links},
+		{name: "html link", text: `<a href="x">x</a>`, want: links},
+		{name: "not a domain", text: "works on v1.2 with node.js, e.g. today", want: ""},
+		{name: "links allowed", text: "docs at https://docs.monad.xyz", allowLinks: true, want: ""},
+		{name: "links allowed but blocked word", text: "https://example.com is shit", allowLinks: true, want: blocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verdict := NewFilter(tt.extra, tt.allowLinks).Check(tt.text)
+			if verdict.Reason != tt.want || verdict.Allowed != (tt.want == "") {
+				t.Errorf("Check(%q) = %+v, want reason %q", tt.text, verdict, tt.want)
+			}
+		})
+	}
+}
+
+func TestSqueeze(t *testing.T) {
+	tests := map[string]string{
+		"":        "",
+		"a":       "a",
+		"shiiiit": "shit",
+		"aabbcc":  "abc",
+		"ééé":     "é",
+		"abab":    "abab",
+	}
+
+	for input, want := range tests {
+		if got := squeeze(input); got != want {
+			t.Errorf("squeeze(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
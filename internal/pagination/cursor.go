@@ -0,0 +1,164 @@
+package pagination
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Cursor is a position in a sorted list: the sort values of the row a page continues from
+type Cursor struct {
+	Values   []interface{}
+	Backward bool // Page toward the start of the list
+}
+
+// Request selects a page of a sorted list, from Cursor when one is given and from Offset otherwise
+type Request struct {
+	SortBy    string
+	SortOrder string
+	Cursor    string
+	Offset    int
+	Limit     int
+}
+
+// Cursors holds the tokens of the pages either side of a page; a token is empty when there is no such page
+type Cursors struct {
+	Next string
+	Prev string
+}
+
+// token is the JSON form of a cursor; it records the sort it was made for
+type token struct {
+	Sort     string        `json:"s"`
+	Values   []interface{} `json:"v"`
+	Backward bool          `json:"b,omitempty"`
+}
+
+// EncodeCursor encodes a position in the sorted list as an opaque, URL-safe token
+func (s Sort) EncodeCursor(values []interface{}, backward bool) (string, error) {
+	encoded := make([]interface{}, len(values))
+	for i, value := range values {
+		if t, ok := value.(time.Time); ok {
+			value = t.UTC().Format(time.RFC3339Nano)
+		}
+		encoded[i] = value
+	}
+
+	data, err := json.Marshal(token{Sort: s.String(), Values: encoded, Backward: backward})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor decodes a cursor token made for this sort. An empty token decodes to nil
+func (s Sort) DecodeCursor(encoded string) (*Cursor, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("INVALID_CURSOR: malformed cursor")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var t token
+	if err := decoder.Decode(&t); err != nil {
+		return nil, fmt.Errorf("INVALID_CURSOR: malformed cursor")
+	}
+	if t.Sort != s.String() || len(t.Values) != len(s) {
+		return nil, fmt.Errorf("INVALID_CURSOR: cursor belongs to a different sort order")
+	}
+
+	values := make([]interface{}, len(s))
+	for i, key := range s {
+		value, ok := decodeValue(key.Kind, t.Values[i])
+		if !ok {
+			return nil, fmt.Errorf("INVALID_CURSOR: malformed cursor")
+		}
+		values[i] = value
+	}
+	return &Cursor{Values: values, Backward: t.Backward}, nil
+}
+
+// decodeValue restores a sort value of the given kind from its JSON form
+func decodeValue(kind Kind, value interface{}) (interface{}, bool) {
+	switch kind {
+	case Time:
+		s, ok := value.(string)
+		if !ok {
+			return nil, false
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		return t, err == nil
+	case Int:
+		n, ok := value.(json.Number)
+		if !ok {
+			return nil, false
+		}
+		i, err := n.Int64()
+		return i, err == nil
+	case Float:
+		n, ok := value.(json.Number)
+		if !ok {
+			return nil, false
+		}
+		f, err := n.Float64()
+		return f, err == nil
+	default:
+		s, ok := value.(string)
+		return s, ok
+	}
+}
+
+// Trim cuts rows loaded with a limit of limit+1 down to the page and puts backward pages back in order.
+// It reports whether there were rows beyond the page
+func Trim[T any](rows []T, limit int, cursor *Cursor) ([]T, bool) {
+	more := len(rows) > limit
+	if more {
+		rows = rows[:limit]
+	}
+	if cursor != nil && cursor.Backward {
+		slices.Reverse(rows)
+	}
+	return rows, more
+}
+
+// Cursors returns the cursors of the pages either side of a page of count rows.
+// The page was loaded from cursor, or from offset when cursor is nil; more is as reported by Trim,
+// and values loads the sort values of the page's first or last row
+func (s Sort) Cursors(cursor *Cursor, offset, count int, more bool, values func(last bool) ([]interface{}, error)) (Cursors, error) {
+	var cursors Cursors
+	if count == 0 {
+		return cursors, nil
+	}
+
+	hasNext, hasPrev := more, cursor != nil || offset > 0
+	if cursor != nil && cursor.Backward {
+		hasNext, hasPrev = true, more
+	}
+
+	if hasNext {
+		last, err := values(true)
+		if err != nil {
+			return cursors, err
+		}
+		if cursors.Next, err = s.EncodeCursor(last, false); err != nil {
+			return cursors, err
+		}
+	}
+	if hasPrev {
+		first, err := values(false)
+		if err != nil {
+			return cursors, err
+		}
+		if cursors.Prev, err = s.EncodeCursor(first, true); err != nil {
+			return cursors, err
+		}
+	}
+	return cursors, nil
+}
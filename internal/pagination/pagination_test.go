@@ -0,0 +1,294 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var testFields = Fields{
+	"id":        {SQL: "items.id", Kind: Int, DefaultDesc: true},
+	"name":      {SQL: "items.name", Kind: String},
+	"createdAt": {SQL: "items.created_at", Kind: Time, DefaultDesc: true},
+	"score":     {SQL: "items.score", Kind: Float, DefaultDesc: true},
+	"rank":      {SQL: "similarity(?, items.name)", Vars: []interface{}{"q"}, Kind: Float, DefaultDesc: true},
+}
+
+func mustParseSort(t *testing.T, sortBy, sortOrder string) Sort {
+	t.Helper()
+	sort, err := ParseSort(sortBy, sortOrder, testFields, "createdAt")
+	if err != nil {
+		t.Fatalf("ParseSort(%q, %q): %v", sortBy, sortOrder, err)
+	}
+	return sort
+}
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		name      string
+		sortBy    string
+		sortOrder string
+		want      string
+		wantErr   string
+	}{
+		{name: "fallback", want: "createdAt:desc,id:desc"},
+		{name: "blank uses fallback and ignores order", sortBy: " ", sortOrder: "asc", want: "createdAt:desc,id:desc"},
+		{name: "field default", sortBy: "name", want: "name:asc,id:asc"},
+		{name: "order for all fields", sortBy: "name,score", sortOrder: "desc", want: "name:desc,score:desc,id:desc"},
+		{name: "order per field", sortBy: "name,score", sortOrder: "desc,asc", want: "name:desc,score:asc,id:asc"},
+		{name: "suffix wins over order", sortBy: "name:asc,score", sortOrder: "desc", want: "name:asc,score:desc,id:desc"},
+		{name: "case-insensitive direction", sortBy: "score:ASC", want: "score:asc,id:asc"},
+		{name: "explicit id is not repeated", sortBy: "name,id:asc", want: "name:asc,id:asc"},
+		{name: "unknown field", sortBy: "password", wantErr: `INVALID_SORT: cannot sort by "password"`},
+		{name: "sql is not a field", sortBy: "items.name", wantErr: "INVALID_SORT: cannot sort by"},
+		{name: "repeated field", sortBy: "name,name:desc", wantErr: "INVALID_SORT: \"name\" is listed more than once"},
+		{name: "too many fields", sortBy: "name,score,createdAt,id", wantErr: "INVALID_SORT: at most 3 sort fields"},
+		{name: "bad direction", sortBy: "name:up", wantErr: "INVALID_SORT: sort direction must be asc or desc"},
+		{name: "order count mismatch", sortBy: "name,score,createdAt", sortOrder: "asc,desc", wantErr: "INVALID_SORT: sortOrder must give"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort, err := ParseSort(tt.sortBy, tt.sortOrder, testFields, "createdAt")
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want prefix %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := sort.String(); got != tt.want {
+				t.Errorf("sort = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSortWithoutID(t *testing.T) {
+	if _, err := ParseSort("name", "", Fields{"name": testFields["name"]}, "name"); err == nil {
+		t.Fatal("expected an error for fields without id")
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 30, 0, 123456789, time.FixedZone("CEST", 2*60*60))
+
+	tests := []struct {
+		name     string
+		sortBy   string
+		values   []interface{}
+		backward bool
+		want     []interface{}
+	}{
+		{
+			name:   "time and int",
+			sortBy: "createdAt",
+			values: []interface{}{created, int64(42)},
+			want:   []interface{}{created.UTC(), int64(42)},
+		},
+		{
+			name:     "string backward",
+			sortBy:   "name",
+			values:   []interface{}{"Ünïcode, \"quoted\"", int64(7)},
+			backward: true,
+			want:     []interface{}{"Ünïcode, \"quoted\"", int64(7)},
+		},
+		{
+			name:   "float and large id",
+			sortBy: "score",
+			values: []interface{}{0.125, int64(9007199254740993)},
+			want:   []interface{}{0.125, int64(9007199254740993)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort := mustParseSort(t, tt.sortBy, "")
+			encoded, err := sort.EncodeCursor(tt.values, tt.backward)
+			if err != nil {
+				t.Fatalf("EncodeCursor: %v", err)
+			}
+			if strings.ContainsAny(encoded, "+/=") {
+				t.Errorf("cursor %q is not URL-safe", encoded)
+			}
+
+			cursor, err := sort.DecodeCursor(encoded)
+			if err != nil {
+				t.Fatalf("DecodeCursor: %v", err)
+			}
+			if cursor.Backward != tt.backward {
+				t.Errorf("backward = %v, want %v", cursor.Backward, tt.backward)
+			}
+			if !reflect.DeepEqual(cursor.Values, tt.want) {
+				t.Errorf("values = %#v, want %#v", cursor.Values, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorRejects(t *testing.T) {
+	sort := mustParseSort(t, "createdAt", "")
+	other, err := mustParseSort(t, "createdAt:asc", "").EncodeCursor([]interface{}{time.Now(), int64(1)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw := func(json string) string { return base64.RawURLEncoding.EncodeToString([]byte(json)) }
+
+	tests := []struct {
+		name    string
+		cursor  string
+		wantErr string
+	}{
+		{name: "not base64", cursor: "%%%", wantErr: "INVALID_CURSOR: malformed cursor"},
+		{name: "not json", cursor: raw("{"), wantErr: "INVALID_CURSOR: malformed cursor"},
+		{name: "other sort", cursor: other, wantErr: "INVALID_CURSOR: cursor belongs to a different sort order"},
+		{name: "missing values", cursor: raw(`{"s":"createdAt:desc,id:desc","v":["2024-01-01T00:00:00Z"]}`), wantErr: "INVALID_CURSOR: cursor belongs to a different sort order"},
+		{name: "bad time", cursor: raw(`{"s":"createdAt:desc,id:desc","v":["yesterday",1]}`), wantErr: "INVALID_CURSOR: malformed cursor"},
+		{name: "fractional id", cursor: raw(`{"s":"createdAt:desc,id:desc","v":["2024-01-01T00:00:00Z",1.5]}`), wantErr: "INVALID_CURSOR: malformed cursor"},
+		{name: "id as string", cursor: raw(`{"s":"createdAt:desc,id:desc","v":["2024-01-01T00:00:00Z","1"]}`), wantErr: "INVALID_CURSOR: malformed cursor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := sort.DecodeCursor(tt.cursor); err == nil || err.Error() != tt.wantErr {
+				t.Errorf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if cursor, err := sort.DecodeCursor(""); cursor != nil || err != nil {
+		t.Errorf("empty cursor = %v, %v; want nil, nil", cursor, err)
+	}
+}
+
+// dryRunDB builds SQL for postgres without connecting to a database
+func dryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("open dry-run db: %v", err)
+	}
+	return db
+}
+
+func TestApply(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		sortBy    string
+		sortOrder string
+		cursor    *Cursor
+		wantSQL   string
+		wantVars  []interface{}
+	}{
+		{
+			name:    "first page",
+			sortBy:  "createdAt",
+			wantSQL: "SELECT * FROM \"items\" ORDER BY items.created_at DESC, items.id DESC",
+		},
+		{
+			name:      "ascending with placeholders",
+			sortBy:    "rank",
+			sortOrder: "asc",
+			wantSQL:   "SELECT * FROM \"items\" ORDER BY similarity($1, items.name) ASC, items.id ASC",
+			wantVars:  []interface{}{"q"},
+		},
+		{
+			name:     "forward cursor",
+			sortBy:   "createdAt",
+			cursor:   &Cursor{Values: []interface{}{created, int64(10)}},
+			wantSQL:  "SELECT * FROM \"items\" WHERE (((items.created_at) < $1) OR ((items.created_at) = $2 AND (items.id) < $3)) ORDER BY items.created_at DESC, items.id DESC",
+			wantVars: []interface{}{created, created, int64(10)},
+		},
+		{
+			name:     "backward cursor reverses order and comparisons",
+			sortBy:   "name,score:desc",
+			cursor:   &Cursor{Values: []interface{}{"b", 1.5, int64(3)}, Backward: true},
+			wantSQL:  "SELECT * FROM \"items\" WHERE (((items.name) < $1) OR ((items.name) = $2 AND (items.score) > $3) OR ((items.name) = $4 AND (items.score) = $5 AND (items.id) > $6)) ORDER BY items.name DESC, items.score ASC, items.id ASC",
+			wantVars: []interface{}{"b", "b", 1.5, "b", 1.5, int64(3)},
+		},
+	}
+
+	db := dryRunDB(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort := mustParseSort(t, tt.sortBy, tt.sortOrder)
+			stmt := sort.Apply(db.Table("items"), tt.cursor).Find(&[]map[string]interface{}{}).Statement
+			if got := stmt.SQL.String(); got != tt.wantSQL {
+				t.Errorf("sql =\n  %s\nwant\n  %s", got, tt.wantSQL)
+			}
+			if len(stmt.Vars) != len(tt.wantVars) || (len(tt.wantVars) > 0 && !reflect.DeepEqual(stmt.Vars, tt.wantVars)) {
+				t.Errorf("vars = %#v, want %#v", stmt.Vars, tt.wantVars)
+			}
+		})
+	}
+}
+
+func TestTrimAndCursors(t *testing.T) {
+	sort := mustParseSort(t, "name", "")
+	values := func(rows []string) func(bool) ([]interface{}, error) {
+		return func(last bool) ([]interface{}, error) {
+			row := rows[0]
+			if last {
+				row = rows[len(rows)-1]
+			}
+			return []interface{}{row, int64(len(row))}, nil
+		}
+	}
+
+	tests := []struct {
+		name     string
+		rows     []string
+		cursor   *Cursor
+		offset   int
+		wantRows []string
+		wantNext bool
+		wantPrev bool
+	}{
+		{name: "only page", rows: []string{"a", "b"}, wantRows: []string{"a", "b"}},
+		{name: "first page of many", rows: []string{"a", "b", "c"}, wantRows: []string{"a", "b"}, wantNext: true},
+		{name: "offset page", rows: []string{"c", "d"}, offset: 2, wantRows: []string{"c", "d"}, wantPrev: true},
+		{name: "forward cursor", rows: []string{"c", "d", "e"}, cursor: &Cursor{}, wantRows: []string{"c", "d"}, wantNext: true, wantPrev: true},
+		{name: "backward cursor at start", rows: []string{"b", "a"}, cursor: &Cursor{Backward: true}, wantRows: []string{"a", "b"}, wantNext: true},
+		{name: "backward cursor in middle", rows: []string{"d", "c", "b"}, cursor: &Cursor{Backward: true}, wantRows: []string{"c", "d"}, wantNext: true, wantPrev: true},
+		{name: "empty page", rows: nil, cursor: &Cursor{}, wantRows: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, more := Trim(append([]string(nil), tt.rows...), 2, tt.cursor)
+			if !reflect.DeepEqual(rows, tt.wantRows) {
+				t.Fatalf("rows = %v, want %v", rows, tt.wantRows)
+			}
+
+			cursors, err := sort.Cursors(tt.cursor, tt.offset, len(rows), more, values(rows))
+			if err != nil {
+				t.Fatalf("Cursors: %v", err)
+			}
+			if (cursors.Next != "") != tt.wantNext || (cursors.Prev != "") != tt.wantPrev {
+				t.Fatalf("next = %q, prev = %q; want next %v, prev %v", cursors.Next, cursors.Prev, tt.wantNext, tt.wantPrev)
+			}
+
+			if tt.wantNext {
+				next, err := sort.DecodeCursor(cursors.Next)
+				if err != nil || next.Backward || next.Values[0] != rows[len(rows)-1] {
+					t.Errorf("next cursor = %+v, %v; want forward from %q", next, err, rows[len(rows)-1])
+				}
+			}
+			if tt.wantPrev {
+				prev, err := sort.DecodeCursor(cursors.Prev)
+				if err != nil || !prev.Backward || prev.Values[0] != rows[0] {
+					t.Errorf("prev cursor = %+v, %v; want backward from %q", prev, err, rows[0])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Package pagination sorts list queries by whitelisted fields and pages through them with opaque keyset cursors.
+package pagination
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// maxSortKeys limits how many fields a list may be sorted by, not counting the tie-breaker
+const maxSortKeys = 3
+
+// Kind is the type of a sort field's values, used to restore them from a cursor
+type Kind int
+
+const (
+	Time Kind = iota
+	Int
+	Float
+	String
+)
+
+// Field is a column or expression a list can be sorted by. It must never be NULL
+type Field struct {
+	SQL         string        // Column or expression, may contain ? placeholders
+	Vars        []interface{} // Values for the placeholders in SQL
+	Kind        Kind
+	DefaultDesc bool // Direction used when the request gives none
+}
+
+// Fields whitelists the sort fields of a list by their API names.
+// "id" must name a unique column; it is appended to every sort to break ties
+type Fields map[string]Field
+
+// Key is one term of a sort
+type Key struct {
+	Name string
+	Field
+	Desc bool
+}
+
+// Sort is a parsed sort order. It always ends in a unique key, so every row has a distinct position
+type Sort []Key
+
+// ParseSort parses a comma-separated list of sort fields, each optionally suffixed with ":asc" or ":desc".
+// Fields without a suffix take their direction from sortOrder, either one direction for all of them or
+// a comma-separated list matching sortBy, and otherwise from the field's default. An empty sortBy uses fallback
+func ParseSort(sortBy, sortOrder string, fields Fields, fallback string) (Sort, error) {
+	if strings.TrimSpace(sortBy) == "" {
+		sortBy, sortOrder = fallback, ""
+	}
+
+	terms := strings.Split(sortBy, ",")
+	if len(terms) > maxSortKeys {
+		return nil, fmt.Errorf("INVALID_SORT: at most %d sort fields are allowed", maxSortKeys)
+	}
+
+	var orders []string
+	if strings.TrimSpace(sortOrder) != "" {
+		orders = strings.Split(sortOrder, ",")
+		if len(orders) != 1 && len(orders) != len(terms) {
+			return nil, fmt.Errorf("INVALID_SORT: sortOrder must give one direction or one per sort field")
+		}
+	}
+
+	sort := make(Sort, 0, len(terms)+1)
+	seen := make(map[string]bool)
+	unique := false
+	for i, term := range terms {
+		name, direction, _ := strings.Cut(strings.TrimSpace(term), ":")
+		field, ok := fields[name]
+		if !ok {
+			return nil, fmt.Errorf("INVALID_SORT: cannot sort by %q", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("INVALID_SORT: %q is listed more than once", name)
+		}
+		seen[name] = true
+
+		if direction == "" && orders != nil {
+			direction = orders[0]
+			if len(orders) > 1 {
+				direction = orders[i]
+			}
+		}
+		desc := field.DefaultDesc
+		switch strings.ToLower(strings.TrimSpace(direction)) {
+		case "":
+		case "asc":
+			desc = false
+		case "desc":
+			desc = true
+		default:
+			return nil, fmt.Errorf("INVALID_SORT: sort direction must be asc or desc")
+		}
+
+		sort = append(sort, Key{Name: name, Field: field, Desc: desc})
+		if name == "id" {
+			unique = true
+		}
+	}
+
+	if !unique {
+		id, ok := fields["id"]
+		if !ok {
+			return nil, fmt.Errorf("sort fields have no id")
+		}
+		sort = append(sort, Key{Name: "id", Field: id, Desc: sort[len(sort)-1].Desc})
+	}
+	return sort, nil
+}
+
+// String describes the sort, e.g. "likes:desc,id:desc"
+func (s Sort) String() string {
+	terms := make([]string, len(s))
+	for i, key := range s {
+		direction := "asc"
+		if key.Desc {
+			direction = "desc"
+		}
+		terms[i] = key.Name + ":" + direction
+	}
+	return strings.Join(terms, ",")
+}
+
+// Apply orders the query by the sort and, given a cursor, keeps only the rows past the cursor's position.
+// A backward cursor reverses the order, so its rows must be reversed again once loaded; see Trim
+func (s Sort) Apply(query *gorm.DB, cursor *Cursor) *gorm.DB {
+	backward := cursor != nil && cursor.Backward
+
+	terms := make([]string, len(s))
+	var vars []interface{}
+	for i, key := range s {
+		direction := "ASC"
+		if key.Desc != backward {
+			direction = "DESC"
+		}
+		terms[i] = key.SQL + " " + direction
+		vars = append(vars, key.Vars...)
+	}
+	query = query.Clauses(clause.OrderBy{Expression: clause.Expr{SQL: strings.Join(terms, ", "), Vars: vars}})
+
+	if cursor == nil {
+		return query
+	}
+
+	// A row is past the cursor when it equals the cursor on the leading keys and is past it on the next one
+	var conditions []string
+	vars = nil
+	for i, key := range s {
+		var terms []string
+		for j := 0; j < i; j++ {
+			terms = append(terms, "("+s[j].SQL+") = ?")
+			vars = append(vars, s[j].Vars...)
+			vars = append(vars, cursor.Values[j])
+		}
+		op := ">"
+		if key.Desc != backward {
+			op = "<"
+		}
+		terms = append(terms, "("+key.SQL+") "+op+" ?")
+		vars = append(vars, key.Vars...)
+		vars = append(vars, cursor.Values[i])
+		conditions = append(conditions, "("+strings.Join(terms, " AND ")+")")
+	}
+	return query.Where("("+strings.Join(conditions, " OR ")+")", vars...)
+}
+
+// Values loads the sort values of the single row matched by query
+func (s Sort) Values(query *gorm.DB) ([]interface{}, error) {
+	exprs := make([]string, len(s))
+	dest := make([]interface{}, len(s))
+	var vars []interface{}
+	for i, key := range s {
+		exprs[i] = key.SQL
+		vars = append(vars, key.Vars...)
+		switch key.Kind {
+		case Time:
+			dest[i] = new(time.Time)
+		case Int:
+			dest[i] = new(int64)
+		case Float:
+			dest[i] = new(float64)
+		default:
+			dest[i] = new(string)
+		}
+	}
+
+	row := query.Clauses(clause.Select{Expression: clause.Expr{SQL: strings.Join(exprs, ", "), Vars: vars}}).Row()
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	values := make([]interface{}, len(dest))
+	for i, d := range dest {
+		switch v := d.(type) {
+		case *time.Time:
+			values[i] = *v
+		case *int64:
+			values[i] = *v
+		case *float64:
+			values[i] = *v
+		case *string:
+			values[i] = *v
+		}
+	}
+	return values, nil
+}
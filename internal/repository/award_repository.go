@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"monad-devhub-be/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type AwardRepository struct {
+	db *gorm.DB
+}
+
+func NewAwardRepository(db *gorm.DB) *AwardRepository {
+	return &AwardRepository{db: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *AwardRepository) WithTx(tx *gorm.DB) *AwardRepository {
+	return &AwardRepository{db: tx}
+}
+
+// Transaction runs fn inside a database transaction (a savepoint if already in one)
+func (r *AwardRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
+
+// awardEventScope reads each award's event name from its linked event
+func awardEventScope(db *gorm.DB) *gorm.DB {
+	return db.Select("awards.*, events.name AS event").Joins("LEFT JOIN events ON events.id = awards.event_id")
+}
+
+// GetAwards retrieves awards grouped by event and track, optionally limited to one event
+func (r *AwardRepository) GetAwards(eventID *uint) ([]models.Award, error) {
+	var awards []models.Award
+	query := r.db.Model(&models.Award{}).Scopes(awardEventScope)
+	if eventID != nil {
+		query = query.Where("awards.event_id = ?", *eventID)
+	}
+	err := query.Order("events.name ASC NULLS FIRST, awards.track ASC, awards.rank ASC NULLS LAST, awards.name ASC").Find(&awards).Error
+	return awards, err
+}
+
+// GetAwardByID retrieves an award by ID
+func (r *AwardRepository) GetAwardByID(id uint) (*models.Award, error) {
+	var award models.Award
+	err := r.db.Scopes(awardEventScope).First(&award, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &award, nil
+}
+
+// FindAward retrieves an award by its event, track and name
+func (r *AwardRepository) FindAward(eventID *uint, track, name string) (*models.Award, error) {
+	query := r.db.Scopes(awardEventScope).Where("awards.track = ? AND awards.name = ?", track, name)
+	if eventID != nil {
+		query = query.Where("awards.event_id = ?", *eventID)
+	} else {
+		query = query.Where("awards.event_id IS NULL")
+	}
+
+	var award models.Award
+	if err := query.First(&award).Error; err != nil {
+		return nil, err
+	}
+	return &award, nil
+}
+
+// FindOrCreateAward loads the award with the same event, track and name, creating it if it doesn't exist
+func (r *AwardRepository) FindOrCreateAward(award *models.Award) error {
+	existing, err := r.FindAward(award.EventID, award.Track, award.Name)
+	if err == nil {
+		*award = *existing
+		return nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return err
+	}
+	return r.db.Create(award).Error
+}
+
+// CreateAward creates a new award
+func (r *AwardRepository) CreateAward(award *models.Award) error {
+	return r.db.Create(award).Error
+}
+
+// UpdateAward saves an award
+func (r *AwardRepository) UpdateAward(award *models.Award) error {
+	return r.db.Save(award).Error
+}
+
+// DeleteAward deletes an award
+func (r *AwardRepository) DeleteAward(id uint) error {
+	return r.db.Delete(&models.Award{}, id).Error
+}
+
+// CountGrants counts the projects an award has been granted to
+func (r *AwardRepository) CountGrants(awardID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.ProjectAward{}).Where("award_id = ?", awardID).Count(&count).Error
+	return count, err
+}
+
+// GetProjectAward retrieves the grant of an award to a project
+func (r *AwardRepository) GetProjectAward(projectID, awardID uint) (*models.ProjectAward, error) {
+	var grant models.ProjectAward
+	err := r.db.Preload("Award", awardEventScope).Where("project_id = ? AND award_id = ?", projectID, awardID).First(&grant).Error
+	if err != nil {
+		return nil, err
+	}
+	return &grant, nil
+}
+
+// GrantAward grants an award to a project
+func (r *AwardRepository) GrantAward(grant *models.ProjectAward) error {
+	return r.db.Create(grant).Error
+}
+
+// RevokeAward removes an award from a project and reports whether it had been granted
+func (r *AwardRepository) RevokeAward(projectID, awardID uint) (bool, error) {
+	result := r.db.Where("project_id = ? AND award_id = ?", projectID, awardID).Delete(&models.ProjectAward{})
+	return result.RowsAffected > 0, result.Error
+}
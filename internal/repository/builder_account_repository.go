@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"time"
+
+	"monad-devhub-be/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BuilderAccountRepository struct {
+	db *gorm.DB
+}
+
+func NewBuilderAccountRepository(db *gorm.DB) *BuilderAccountRepository {
+	return &BuilderAccountRepository{db: db}
+}
+
+// CreateNonce stores a sign-in nonce and clears out expired ones
+func (r *BuilderAccountRepository) CreateNonce(nonce *models.SiweNonce) error {
+	if err := r.db.Where("expires_at <= ?", time.Now()).Delete(&models.SiweNonce{}).Error; err != nil {
+		return err
+	}
+	return r.db.Create(nonce).Error
+}
+
+// ConsumeNonce deletes an unexpired nonce, reporting whether it was there to use
+func (r *BuilderAccountRepository) ConsumeNonce(nonce string, now time.Time) (bool, error) {
+	result := r.db.Where("nonce = ? AND expires_at > ?", nonce, now).Delete(&models.SiweNonce{})
+	return result.RowsAffected > 0, result.Error
+}
+
+// RecordLogin creates the account for an address on its first sign-in and stamps the login time
+func (r *BuilderAccountRepository) RecordLogin(address string, now time.Time) (*models.BuilderAccount, error) {
+	account := &models.BuilderAccount{Address: address, LastLoginAt: &now}
+	err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "address"}},
+		DoUpdates: clause.AssignmentColumns([]string{"last_login_at", "updated_at"}),
+	}).Create(account).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.GetAccountByAddress(address)
+}
+
+// GetAccountByAddress retrieves the account of a lowercase address
+func (r *BuilderAccountRepository) GetAccountByAddress(address string) (*models.BuilderAccount, error) {
+	var account models.BuilderAccount
+	err := r.db.Where("address = ?", address).First(&account).Error
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// GetOwnedProjects retrieves the projects an address owns, published or not, newest first
+func (r *BuilderAccountRepository) GetOwnedProjects(address string) ([]models.Project, error) {
+	var projects []models.Project
+	err := r.db.Preload("TeamMembers").
+		Where("owner_address = ?", address).
+		Order("created_at DESC").
+		Find(&projects).Error
+	return projects, err
+}
+
+// SetProjectOwner assigns a project to an address, or clears its owner when address is nil
+func (r *BuilderAccountRepository) SetProjectOwner(projectID uint, address *string) error {
+	result := r.db.Model(&models.Project{}).Where("id = ?", projectID).Update("owner_address", address)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
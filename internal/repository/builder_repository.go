@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/utils"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BuilderRepository struct {
+	db *gorm.DB
+}
+
+func NewBuilderRepository(db *gorm.DB) *BuilderRepository {
+	return &BuilderRepository{db: db}
+}
+
+// BuilderListItem is a builder in the directory together with counts over their published projects
+type BuilderListItem struct {
+	models.Builder `gorm:"embedded"`
+	ProjectCount   int `json:"projectCount"`
+	AwardCount     int `json:"awardCount"`
+}
+
+// builderDirectory selects builders that worked on at least one published project
+func builderDirectory(db *gorm.DB, search string) *gorm.DB {
+	query := db.Model(&models.Builder{}).
+		Joins("JOIN team_members ON team_members.builder_id = builders.id").
+		Joins("JOIN projects ON projects.id = team_members.project_id AND projects.deleted_at IS NULL").
+		Scopes(publishedScope)
+	if search != "" {
+		query = query.Where("builders.handle ILIKE ? OR builders.name ILIKE ?", "%"+search+"%", "%"+search+"%")
+	}
+	return query
+}
+
+// GetBuilders retrieves a page of the builder directory, most prolific builders first
+func (r *BuilderRepository) GetBuilders(offset, limit int, search string) ([]BuilderListItem, error) {
+	var builders []BuilderListItem
+	err := builderDirectory(r.db, search).
+		Select(`builders.*,
+			COUNT(DISTINCT projects.id) AS project_count,
+			COUNT(DISTINCT project_awards.id) AS award_count`).
+		Joins("LEFT JOIN project_awards ON project_awards.project_id = projects.id").
+		Group("builders.id").
+		Order("project_count DESC, award_count DESC, builders.handle ASC").
+		Offset(offset).Limit(limit).
+		Scan(&builders).Error
+	return builders, err
+}
+
+// GetBuildersCount counts the builders in the directory
+func (r *BuilderRepository) GetBuildersCount(search string) (int64, error) {
+	var count int64
+	err := builderDirectory(r.db, search).Distinct("builders.id").Count(&count).Error
+	return count, err
+}
+
+// GetBuilderByID retrieves a builder by ID
+func (r *BuilderRepository) GetBuilderByID(id uint) (*models.Builder, error) {
+	var builder models.Builder
+	err := r.db.First(&builder, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &builder, nil
+}
+
+// GetBuilderByHandle retrieves a builder by normalized handle, including handles merged into it
+func (r *BuilderRepository) GetBuilderByHandle(handle string) (*models.Builder, error) {
+	var builder models.Builder
+	err := r.db.Where("handle = ? OR ? = ANY(aliases)", handle, handle).
+		Order(clause.Expr{SQL: "handle = ? DESC", Vars: []interface{}{handle}}).
+		First(&builder).Error
+	if err != nil {
+		return nil, err
+	}
+	return &builder, nil
+}
+
+// GetBuilderProjects retrieves the published projects a builder worked on, newest first
+func (r *BuilderRepository) GetBuilderProjects(builderID uint) ([]models.Project, error) {
+	var projects []models.Project
+	err := r.db.Preload("TeamMembers").Preload("Awards.Award", awardEventScope).
+		Scopes(publishedScope).
+		Where("projects.id IN (SELECT project_id FROM team_members WHERE builder_id = ?)", builderID).
+		Order("created_at DESC").
+		Find(&projects).Error
+	return projects, err
+}
+
+// MergeBuilders moves the team memberships of the sources to the target and deletes the sources.
+// Their handles become aliases of the target so old profile links keep working.
+func (r *BuilderRepository) MergeBuilders(target *models.Builder, sources []models.Builder) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		sourceIDs := make([]uint, len(sources))
+		aliases := append([]string{}, target.Aliases...)
+		for i, source := range sources {
+			sourceIDs[i] = source.ID
+			aliases = append(aliases, source.Handle)
+			aliases = append(aliases, source.Aliases...)
+			if target.Name == "" {
+				target.Name = source.Name
+			}
+			if target.Image == "" {
+				target.Image = source.Image
+			}
+		}
+		target.Aliases = pq.StringArray{}
+		for _, alias := range utils.RemoveDuplicates(aliases) {
+			if alias != target.Handle {
+				target.Aliases = append(target.Aliases, alias)
+			}
+		}
+
+		if err := tx.Model(&models.TeamMember{}).Where("builder_id IN ?", sourceIDs).Update("builder_id", target.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.Builder{}, sourceIDs).Error; err != nil {
+			return err
+		}
+		return tx.Save(target).Error
+	})
+}
+
+// linkBuilders links team members to the builder with their handle, creating builders on first use
+func linkBuilders(db *gorm.DB, members []models.TeamMember) error {
+	for i := range members {
+		if err := linkBuilder(db, &members[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// linkBuilder sets the builder of a team member from its current handle, so a changed handle moves the member
+// to the matching builder; members without a usable handle are unlinked
+func linkBuilder(db *gorm.DB, member *models.TeamMember) error {
+	handle := utils.NormalizeTwitterHandle(member.Twitter)
+	if handle == "" {
+		member.BuilderID = nil
+		return nil
+	}
+
+	builder, err := (&BuilderRepository{db: db}).GetBuilderByHandle(handle)
+	if err == gorm.ErrRecordNotFound {
+		// A concurrent insert of the same handle wins; reload it afterwards
+		created := &models.Builder{Handle: handle, Name: member.Name, Image: member.Image}
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(created).Error; err != nil {
+			return err
+		}
+		builder, err = (&BuilderRepository{db: db}).GetBuilderByHandle(handle)
+	}
+	if err != nil {
+		return err
+	}
+
+	member.BuilderID = &builder.ID
+	return nil
+}
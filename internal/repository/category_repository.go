@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"monad-devhub-be/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type CategoryRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) *CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+// GetCategories retrieves every category in display order
+func (r *CategoryRepository) GetCategories() ([]models.Category, error) {
+	var categories []models.Category
+	err := r.db.Order("display_order ASC, name ASC").Find(&categories).Error
+	return categories, err
+}
+
+// GetCategoryByID retrieves a category by ID
+func (r *CategoryRepository) GetCategoryByID(id uint) (*models.Category, error) {
+	var category models.Category
+	err := r.db.First(&category, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// CreateCategory creates a new category; stored category arrays carrying its name in another case
+// or one of its aliases are migrated to its name
+func (r *CategoryRepository) CreateCategory(category *models.Category) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(category).Error; err != nil {
+			return err
+		}
+		labels := append([]string{category.Name}, category.Aliases...)
+		return (&CategoryRepository{db: tx}).replaceLabels(labels, category.Name)
+	})
+}
+
+// UpdateCategory saves a category; stored category arrays carrying its previous name, its name in another
+// case or one of its aliases are migrated to its name
+func (r *CategoryRepository) UpdateCategory(category *models.Category, previousName string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(category).Error; err != nil {
+			return err
+		}
+		labels := append([]string{previousName, category.Name}, category.Aliases...)
+		return (&CategoryRepository{db: tx}).replaceLabels(labels, category.Name)
+	})
+}
+
+// replaceLabels renames each of labels to name inside the stored category arrays
+func (r *CategoryRepository) replaceLabels(labels []string, name string) error {
+	for _, label := range labels {
+		if err := r.ReplaceCategoryName(label, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReplaceCategoryName renames a category inside the categories arrays of projects and submissions, matching
+// the old name in any case. Rows that already carry the new name just drop the old one, so no duplicates are created.
+func (r *CategoryRepository) ReplaceCategoryName(oldName, newName string) error {
+	for _, model := range []interface{}{&models.Project{}, &models.Submission{}} {
+		err := r.db.Model(model).Unscoped().
+			Where("EXISTS (SELECT 1 FROM unnest(categories) AS c WHERE lower(c) = lower(?) AND c <> ?)", oldName, newName).
+			UpdateColumn("categories", gorm.Expr(`ARRAY(
+				SELECT label FROM (
+					SELECT CASE WHEN lower(c) = lower(?) THEN ? ELSE c END AS label, MIN(position) AS position
+					FROM unnest(categories) WITH ORDINALITY AS u(c, position)
+					GROUP BY 1
+				) renamed
+				ORDER BY position
+			)`, oldName, newName)).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CountCategoryUsage counts the projects and submissions tagged with a category
+func (r *CategoryRepository) CountCategoryUsage(name string) (int64, error) {
+	var projects, submissions int64
+	if err := r.db.Model(&models.Project{}).Unscoped().Where("? = ANY(categories)", name).Count(&projects).Error; err != nil {
+		return 0, err
+	}
+	if err := r.db.Model(&models.Submission{}).Where("? = ANY(categories)", name).Count(&submissions).Error; err != nil {
+		return 0, err
+	}
+	return projects + submissions, nil
+}
+
+// DeleteCategory deletes a category
+func (r *CategoryRepository) DeleteCategory(id uint) error {
+	return r.db.Delete(&models.Category{}, id).Error
+}
@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"time"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ChangeRequestRepository struct {
+	db *gorm.DB
+}
+
+func NewChangeRequestRepository(db *gorm.DB) *ChangeRequestRepository {
+	return &ChangeRequestRepository{db: db}
+}
+
+// ChangeRequestFilter narrows the list of change requests
+type ChangeRequestFilter struct {
+	ProjectID   *uint
+	Status      string
+	RequestedBy string
+}
+
+// ProjectEdit is a set of changes to a project, from an approved change request or an admin edit
+type ProjectEdit struct {
+	Columns map[string]interface{}   // Project columns to update
+	Team    []models.TeamMemberInput // Replaces the team when not nil
+	Logs    []models.ProjectChangeLog
+}
+
+// GetChangeRequests retrieves change requests, newest first, with their projects' names
+func (r *ChangeRequestRepository) GetChangeRequests(filter ChangeRequestFilter, offset, limit int) ([]models.ProjectChangeRequest, int64, error) {
+	query := r.db.Model(&models.ProjectChangeRequest{})
+	if filter.ProjectID != nil {
+		query = query.Where("project_id = ?", *filter.ProjectID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.RequestedBy != "" {
+		query = query.Where("requested_by = ?", filter.RequestedBy)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var requests []models.ProjectChangeRequest
+	err := query.Preload("Project", func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped().Select("id", "name", "owner_address")
+	}).Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&requests).Error
+	return requests, total, err
+}
+
+// GetChangeRequestByID retrieves a change request by ID
+func (r *ChangeRequestRepository) GetChangeRequestByID(id uint) (*models.ProjectChangeRequest, error) {
+	var request models.ProjectChangeRequest
+	err := r.db.First(&request, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// HasPendingChangeRequest reports whether a project has a change request awaiting review
+func (r *ChangeRequestRepository) HasPendingChangeRequest(projectID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.ProjectChangeRequest{}).
+		Where("project_id = ? AND status = 'pending'", projectID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// CreateChangeRequest stores a change request awaiting review
+func (r *ChangeRequestRepository) CreateChangeRequest(request *models.ProjectChangeRequest) error {
+	return r.db.Create(request).Error
+}
+
+// CreateAppliedChangeRequest stores an auto-approved change request and applies it in the same transaction
+func (r *ChangeRequestRepository) CreateAppliedChangeRequest(request *models.ProjectChangeRequest, edit *ProjectEdit) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(request).Error; err != nil {
+			return err
+		}
+		return applyProjectEdit(tx, request.ProjectID, &request.ID, edit)
+	})
+}
+
+// ReviewChangeRequest moves a pending change request to its reviewed status and applies the edit, if any,
+// in the same transaction. It reports false when the request was no longer pending.
+func (r *ChangeRequestRepository) ReviewChangeRequest(id uint, status string, reviewerID *uint, note *string, edit *ProjectEdit) (bool, error) {
+	reviewed, _, err := r.reviewChangeRequest(id, status, reviewerID, note, edit, nil)
+	return reviewed, err
+}
+
+// ApproveChangeRequest approves a pending change request and applies its edit in the same transaction, unless
+// the project moved past version in the meantime. It reports whether the request was still pending and whether
+// the project was still at version; nothing is changed unless both hold.
+func (r *ChangeRequestRepository) ApproveChangeRequest(id uint, reviewerID *uint, note *string, edit *ProjectEdit, version time.Time) (bool, bool, error) {
+	return r.reviewChangeRequest(id, "approved", reviewerID, note, edit, &version)
+}
+
+// reviewChangeRequest reviews a pending change request, checking the project's version first when one is given
+func (r *ChangeRequestRepository) reviewChangeRequest(id uint, status string, reviewerID *uint, note *string, edit *ProjectEdit, version *time.Time) (bool, bool, error) {
+	reviewed, current := false, true
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var request models.ProjectChangeRequest
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&request, id).Error; err != nil {
+			return err
+		}
+		if request.Status != "pending" {
+			return nil
+		}
+		if version != nil {
+			var err error
+			if current, err = lockProject(tx, request.ProjectID, version); err != nil || !current {
+				return err
+			}
+		}
+
+		now := time.Now()
+		err := tx.Model(&request).Updates(map[string]interface{}{
+			"status":      status,
+			"reviewer_id": reviewerID,
+			"review_note": note,
+			"reviewed_at": &now,
+		}).Error
+		if err != nil {
+			return err
+		}
+		reviewed = true
+
+		if edit == nil {
+			return nil
+		}
+		return applyProjectEdit(tx, request.ProjectID, &request.ID, edit)
+	})
+	return reviewed, current, err
+}
+
+// GetChangeLog retrieves the recorded changes of a project, newest first
+func (r *ChangeRequestRepository) GetChangeLog(projectID uint, offset, limit int) ([]models.ProjectChangeLog, int64, error) {
+	query := r.db.Model(&models.ProjectChangeLog{}).Where("project_id = ?", projectID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var logs []models.ProjectChangeLog
+	err := query.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&logs).Error
+	return logs, total, err
+}
+
+// applyProjectEdit updates a project's columns and team and records the change log
+func applyProjectEdit(tx *gorm.DB, projectID uint, changeRequestID *uint, edit *ProjectEdit) error {
+	// Team-only edits still move the project's version forward
+	columns := edit.Columns
+	if len(columns) == 0 {
+		columns = map[string]interface{}{"updated_at": time.Now()}
+	}
+	result := tx.Model(&models.Project{}).Where("id = ?", projectID).Updates(columns)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	if edit.Team != nil {
+		if err := replaceTeamMembers(tx, projectID, edit.Team); err != nil {
+			return err
+		}
+	}
+
+	for i := range edit.Logs {
+		edit.Logs[i].ProjectID = projectID
+		edit.Logs[i].ChangeRequestID = changeRequestID
+	}
+	if len(edit.Logs) == 0 {
+		return nil
+	}
+	return tx.Create(&edit.Logs).Error
+}
+
+// replaceTeamMembers makes a project's team match the given members. Members whose twitter handle is
+// already on the team keep their row, photo and builder link; the rest are removed or added.
+func replaceTeamMembers(tx *gorm.DB, projectID uint, members []models.TeamMemberInput) error {
+	var existing []models.TeamMember
+	if err := tx.Where("project_id = ?", projectID).Find(&existing).Error; err != nil {
+		return err
+	}
+	byHandle := make(map[string]models.TeamMember)
+	for _, member := range existing {
+		byHandle[utils.NormalizeTwitterHandle(member.Twitter)] = member
+	}
+
+	kept := make(map[uint]bool)
+	var added []models.TeamMember
+	for _, input := range members {
+		member, ok := byHandle[utils.NormalizeTwitterHandle(input.Twitter)]
+		if ok && !kept[member.ID] {
+			kept[member.ID] = true
+			err := tx.Model(&member).Updates(map[string]interface{}{"name": input.Name, "twitter": input.Twitter}).Error
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		added = append(added, models.TeamMember{ProjectID: projectID, Name: input.Name, Twitter: input.Twitter})
+	}
+
+	for _, member := range existing {
+		if kept[member.ID] {
+			continue
+		}
+		if err := tx.Delete(&models.TeamMember{}, member.ID).Error; err != nil {
+			return err
+		}
+	}
+
+	if len(added) == 0 {
+		return nil
+	}
+	if err := linkBuilders(tx, added); err != nil {
+		return err
+	}
+	return tx.Create(&added).Error
+}
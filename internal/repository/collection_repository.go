@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"monad-devhub-be/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type CollectionRepository struct {
+	db *gorm.DB
+}
+
+func NewCollectionRepository(db *gorm.DB) *CollectionRepository {
+	return &CollectionRepository{db: db}
+}
+
+// GetCollections retrieves collections in display order; drafts only when requested
+func (r *CollectionRepository) GetCollections(includeDrafts bool) ([]models.Collection, error) {
+	var collections []models.Collection
+	query := r.db.Model(&models.Collection{})
+	if !includeDrafts {
+		query = query.Where("status = ?", "published")
+	}
+	err := query.Order("display_order ASC, id ASC").Find(&collections).Error
+	return collections, err
+}
+
+// GetCollectionByID retrieves a collection by ID
+func (r *CollectionRepository) GetCollectionByID(id uint) (*models.Collection, error) {
+	var collection models.Collection
+	err := r.db.First(&collection, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+// GetCollectionBySlug retrieves a collection by slug
+func (r *CollectionRepository) GetCollectionBySlug(slug string) (*models.Collection, error) {
+	var collection models.Collection
+	err := r.db.Where("slug = ?", slug).First(&collection).Error
+	if err != nil {
+		return nil, err
+	}
+	return &collection, nil
+}
+
+// GetCollectionProjects retrieves the projects of a collection in their curated order.
+// Soft-deleted projects are skipped, and unpublished ones too unless requested.
+func (r *CollectionRepository) GetCollectionProjects(collectionID uint, includeUnpublished bool) ([]models.Project, error) {
+	query := r.db.Preload("TeamMembers").Preload("Awards.Award", awardEventScope).
+		Joins("JOIN collection_projects ON collection_projects.project_id = projects.id").
+		Where("collection_projects.collection_id = ?", collectionID)
+	if !includeUnpublished {
+		query = query.Scopes(publishedScope)
+	}
+
+	var projects []models.Project
+	err := query.Order("collection_projects.position ASC").Find(&projects).Error
+	return projects, err
+}
+
+// GetProjectCounts counts the projects of each collection, skipping soft-deleted and, unless requested, unpublished ones
+func (r *CollectionRepository) GetProjectCounts(collectionIDs []uint, includeUnpublished bool) (map[uint]int, error) {
+	counts := make(map[uint]int)
+	if len(collectionIDs) == 0 {
+		return counts, nil
+	}
+
+	query := r.db.Model(&models.CollectionProject{}).
+		Select("collection_projects.collection_id, COUNT(*) AS count").
+		Joins("JOIN projects ON projects.id = collection_projects.project_id AND projects.deleted_at IS NULL").
+		Where("collection_projects.collection_id IN ?", collectionIDs).
+		Group("collection_projects.collection_id")
+	if !includeUnpublished {
+		query = query.Scopes(publishedScope)
+	}
+
+	var rows []struct {
+		CollectionID uint
+		Count        int
+	}
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.CollectionID] = row.Count
+	}
+	return counts, nil
+}
+
+// CountProjects counts how many of the given IDs belong to existing, not deleted projects
+func (r *CollectionRepository) CountProjects(projectIDs []uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Project{}).Where("id IN ?", projectIDs).Count(&count).Error
+	return count, err
+}
+
+// CreateCollection creates a collection with its projects in the given order
+func (r *CollectionRepository) CreateCollection(collection *models.Collection, projectIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(collection).Error; err != nil {
+			return err
+		}
+		return replaceCollectionProjects(tx, collection.ID, projectIDs)
+	})
+}
+
+// UpdateCollection saves a collection; a non-nil projectIDs replaces its projects and their order
+func (r *CollectionRepository) UpdateCollection(collection *models.Collection, projectIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Items").Save(collection).Error; err != nil {
+			return err
+		}
+		if projectIDs == nil {
+			return nil
+		}
+		return replaceCollectionProjects(tx, collection.ID, projectIDs)
+	})
+}
+
+// DeleteCollection deletes a collection; its project placements go with it
+func (r *CollectionRepository) DeleteCollection(id uint) error {
+	result := r.db.Delete(&models.Collection{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// replaceCollectionProjects makes a collection list exactly the given projects, in order
+func replaceCollectionProjects(tx *gorm.DB, collectionID uint, projectIDs []uint) error {
+	if err := tx.Where("collection_id = ?", collectionID).Delete(&models.CollectionProject{}).Error; err != nil {
+		return err
+	}
+	if len(projectIDs) == 0 {
+		return nil
+	}
+
+	items := make([]models.CollectionProject, len(projectIDs))
+	for i, projectID := range projectIDs {
+		items[i] = models.CollectionProject{CollectionID: collectionID, ProjectID: projectID, Position: i}
+	}
+	return tx.Create(&items).Error
+}
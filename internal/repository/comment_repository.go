@@ -0,0 +1,292 @@
+package repository
+
+import (
+	"sort"
+	"time"
+
+	"monad-devhub-be/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CommentRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentRepository(db *gorm.DB) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+// WithTx returns a repository that runs its queries inside the given transaction
+func (r *CommentRepository) WithTx(tx *gorm.DB) *CommentRepository {
+	return &CommentRepository{db: tx}
+}
+
+// Transaction runs fn inside a database transaction (a savepoint if already in one)
+func (r *CommentRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
+
+// LockCommenters takes transaction-scoped advisory locks on the given author keys and IP hashes, so the rate
+// limit checks and inserts of concurrent comments by the same commenter run one after another.
+// It must be called inside a transaction; keys are locked in sorted order to avoid deadlocks
+func (r *CommentRepository) LockCommenters(keys ...string) error {
+	sorted := append([]string{}, keys...)
+	sort.Strings(sorted)
+	for _, key := range sorted {
+		if key == "" {
+			continue
+		}
+		if err := r.db.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "comment:"+key).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CommentFilter narrows the moderation list of comments
+type CommentFilter struct {
+	ProjectID *uint
+	Status    string
+}
+
+// threadOrder sorts top-level comments: pinned first, then newest first
+func threadOrder(db *gorm.DB) *gorm.DB {
+	return db.Order("pinned DESC, created_at DESC, id DESC")
+}
+
+// publicThreadScope restricts top-level comments to those the public can see: visible ones, and
+// hidden or deleted ones that still have visible replies (shown as placeholders)
+func publicThreadScope(db *gorm.DB) *gorm.DB {
+	return db.Where(`comments.status = 'visible' OR EXISTS (
+		SELECT 1 FROM comments replies WHERE replies.root_id = comments.id AND replies.status = 'visible')`)
+}
+
+// GetThreads retrieves a page of a project's top-level comments
+func (r *CommentRepository) GetThreads(projectID uint, offset, limit int, includeHidden bool) ([]models.Comment, error) {
+	query := r.db.Where("project_id = ? AND parent_id IS NULL", projectID)
+	if !includeHidden {
+		query = query.Scopes(publicThreadScope)
+	}
+
+	var comments []models.Comment
+	err := query.Scopes(threadOrder).Offset(offset).Limit(limit).Find(&comments).Error
+	return comments, err
+}
+
+// GetThreadsCount counts a project's top-level comments
+func (r *CommentRepository) GetThreadsCount(projectID uint, includeHidden bool) (int64, error) {
+	query := r.db.Model(&models.Comment{}).Where("project_id = ? AND parent_id IS NULL", projectID)
+	if !includeHidden {
+		query = query.Scopes(publicThreadScope)
+	}
+
+	var count int64
+	err := query.Count(&count).Error
+	return count, err
+}
+
+// GetReplies retrieves every reply in the given threads, oldest first
+func (r *CommentRepository) GetReplies(rootIDs []uint) ([]models.Comment, error) {
+	var replies []models.Comment
+	if len(rootIDs) == 0 {
+		return replies, nil
+	}
+	err := r.db.Where("root_id IN ?", rootIDs).Order("created_at ASC, id ASC").Find(&replies).Error
+	return replies, err
+}
+
+// GetComments retrieves comments across projects for moderation, newest first
+func (r *CommentRepository) GetComments(filter CommentFilter, offset, limit int) ([]models.Comment, int64, error) {
+	query := r.db.Model(&models.Comment{})
+	if filter.ProjectID != nil {
+		query = query.Where("project_id = ?", *filter.ProjectID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var comments []models.Comment
+	err := query.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&comments).Error
+	return comments, total, err
+}
+
+// GetCommentByID retrieves a comment by ID
+func (r *CommentRepository) GetCommentByID(id uint) (*models.Comment, error) {
+	var comment models.Comment
+	err := r.db.First(&comment, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// GetProjectComment retrieves a comment of a project
+func (r *CommentRepository) GetProjectComment(projectID, id uint) (*models.Comment, error) {
+	var comment models.Comment
+	err := r.db.Where("project_id = ?", projectID).First(&comment, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// CreateComment stores a comment and counts it on its project in the same transaction
+func (r *CommentRepository) CreateComment(comment *models.Comment) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(comment).Error; err != nil {
+			return err
+		}
+		if comment.Status != "visible" {
+			return nil
+		}
+		return adjustCommentCount(tx, comment.ProjectID, 1)
+	})
+}
+
+// UpdateCommentStatus changes a comment's status and keeps its project's counter in step.
+// The comment is locked so concurrent moderation cannot count it twice.
+func (r *CommentRepository) UpdateCommentStatus(id uint, status string, note *string, clearBody bool) (*models.Comment, error) {
+	var comment models.Comment
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&comment, id).Error; err != nil {
+			return err
+		}
+
+		delta := 0
+		if comment.Status == "visible" && status != "visible" {
+			delta = -1
+		} else if comment.Status != "visible" && status == "visible" {
+			delta = 1
+		}
+
+		updates := map[string]interface{}{"status": status, "moderation_note": note}
+		if clearBody {
+			updates["body"] = ""
+		}
+		if err := tx.Model(&comment).Updates(updates).Error; err != nil {
+			return err
+		}
+		if delta == 0 {
+			return nil
+		}
+		return adjustCommentCount(tx, comment.ProjectID, delta)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+// SetPinned pins or unpins a comment
+func (r *CommentRepository) SetPinned(id uint, pinned bool) error {
+	return r.db.Model(&models.Comment{}).Where("id = ?", id).Update("pinned", pinned).Error
+}
+
+// HideAuthorComments hides every visible comment of an author and recounts the affected projects
+func (r *CommentRepository) HideAuthorComments(authorKey string, note *string) (int64, error) {
+	var hidden int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var projectIDs []uint
+		err := tx.Model(&models.Comment{}).
+			Where("author_key = ? AND status = 'visible'", authorKey).
+			Distinct("project_id").Pluck("project_id", &projectIDs).Error
+		if err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.Comment{}).
+			Where("author_key = ? AND status = 'visible'", authorKey).
+			Updates(map[string]interface{}{"status": "hidden", "moderation_note": note})
+		if result.Error != nil {
+			return result.Error
+		}
+		hidden = result.RowsAffected
+
+		return recountComments(tx, projectIDs)
+	})
+	return hidden, err
+}
+
+// CountRecentByAuthor counts the comments an author posted since the given time
+func (r *CommentRepository) CountRecentByAuthor(authorKey string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Comment{}).Where("author_key = ? AND created_at >= ?", authorKey, since).Count(&count).Error
+	return count, err
+}
+
+// CountRecentByIP counts the comments posted from an IP address since the given time
+func (r *CommentRepository) CountRecentByIP(ipHash string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Comment{}).Where("ip_hash = ? AND created_at >= ?", ipHash, since).Count(&count).Error
+	return count, err
+}
+
+// FindActiveBan returns an unexpired ban of the author, or a ban that includes their IP address.
+// Bans without an IP address only ever match their own author
+func (r *CommentRepository) FindActiveBan(authorKey, ipHash string, now time.Time) (*models.CommentBan, error) {
+	var ban models.CommentBan
+	err := r.db.Where("expires_at IS NULL OR expires_at > ?", now).
+		Where("author_key = ? OR (ip_hash <> '' AND ip_hash = ?)", authorKey, ipHash).
+		First(&ban).Error
+	if err != nil {
+		return nil, err
+	}
+	return &ban, nil
+}
+
+// GetBans retrieves unexpired bans, newest first
+func (r *CommentRepository) GetBans(now time.Time) ([]models.CommentBan, error) {
+	var bans []models.CommentBan
+	err := r.db.Where("expires_at IS NULL OR expires_at > ?", now).Order("created_at DESC").Find(&bans).Error
+	return bans, err
+}
+
+// CreateBan stores a ban
+func (r *CommentRepository) CreateBan(ban *models.CommentBan) error {
+	return r.db.Create(ban).Error
+}
+
+// DeleteBan lifts a ban, reporting whether it existed
+func (r *CommentRepository) DeleteBan(id uint) (bool, error) {
+	result := r.db.Delete(&models.CommentBan{}, id)
+	return result.RowsAffected > 0, result.Error
+}
+
+// ReconcileCommentCounts recomputes every project's comment counter from its visible comments
+// and returns how many counters were wrong
+func (r *CommentRepository) ReconcileCommentCounts() (int64, error) {
+	result := r.db.Exec(`
+		UPDATE projects SET comments = counted.total
+		FROM (
+			SELECT projects.id, COUNT(comments.id) AS total
+			FROM projects
+			LEFT JOIN comments ON comments.project_id = projects.id AND comments.status = 'visible'
+			GROUP BY projects.id
+		) counted
+		WHERE projects.id = counted.id AND projects.comments IS DISTINCT FROM counted.total`)
+	return result.RowsAffected, result.Error
+}
+
+// adjustCommentCount moves a project's comment counter by delta
+func adjustCommentCount(tx *gorm.DB, projectID uint, delta int) error {
+	return tx.Model(&models.Project{}).Where("id = ?", projectID).
+		UpdateColumn("comments", gorm.Expr("GREATEST(comments + ?, 0)", delta)).Error
+}
+
+// recountComments recomputes the comment counters of the given projects
+func recountComments(tx *gorm.DB, projectIDs []uint) error {
+	if len(projectIDs) == 0 {
+		return nil
+	}
+	return tx.Exec(`
+		UPDATE projects SET comments = (
+			SELECT COUNT(*) FROM comments WHERE comments.project_id = projects.id AND comments.status = 'visible'
+		) WHERE id IN ?`, projectIDs).Error
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"time"
+
+	"monad-devhub-be/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ContractRepository struct {
+	db *gorm.DB
+}
+
+func NewContractRepository(db *gorm.DB) *ContractRepository {
+	return &ContractRepository{db: db}
+}
+
+// ProjectContractItem is a contract declaration together with the name of its project, for the review queue
+type ProjectContractItem struct {
+	models.ProjectContract `gorm:"embedded"`
+	ProjectName            string `json:"projectName"`
+}
+
+// ActivityPoint aggregates the transactions sent to a set of contracts within one time bucket
+type ActivityPoint struct {
+	Bucket        time.Time `json:"bucket"`
+	TxCount       int64     `json:"txCount"`
+	UniqueWallets int64     `json:"uniqueWallets"`
+	GasUsed       int64     `json:"gasUsed"`
+}
+
+// verifiedContractsScope restricts a query on project_contracts to verified declarations
+func verifiedContractsScope(db *gorm.DB) *gorm.DB {
+	return db.Where("project_contracts.status = ?", "verified")
+}
+
+// GetContractDeclarations lists declarations across projects, oldest first, optionally by status
+func (r *ContractRepository) GetContractDeclarations(status string) ([]ProjectContractItem, error) {
+	query := r.db.Model(&models.ProjectContract{}).
+		Select("project_contracts.*, projects.name AS project_name").
+		Joins("JOIN projects ON projects.id = project_contracts.project_id AND projects.deleted_at IS NULL")
+	if status != "" {
+		query = query.Where("project_contracts.status = ?", status)
+	}
+
+	var items []ProjectContractItem
+	err := query.Order("project_contracts.created_at ASC, project_contracts.id ASC").Scan(&items).Error
+	return items, err
+}
+
+// GetProjectContracts retrieves a project's declarations with their linked contracts
+func (r *ContractRepository) GetProjectContracts(projectID uint, verifiedOnly bool) ([]models.ProjectContract, error) {
+	query := r.db.Preload("Contract").Where("project_id = ?", projectID)
+	if verifiedOnly {
+		query = query.Scopes(verifiedContractsScope)
+	}
+
+	var contracts []models.ProjectContract
+	err := query.Order("id ASC").Find(&contracts).Error
+	return contracts, err
+}
+
+// GetProjectContractByID retrieves one declaration of a project
+func (r *ContractRepository) GetProjectContractByID(projectID, id uint) (*models.ProjectContract, error) {
+	var contract models.ProjectContract
+	err := r.db.Preload("Contract").Where("project_id = ?", projectID).First(&contract, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &contract, nil
+}
+
+// CreateProjectContract stores a new declaration
+func (r *ContractRepository) CreateProjectContract(contract *models.ProjectContract) error {
+	return r.db.Create(contract).Error
+}
+
+// UpdateProjectContract saves a declaration's review state
+func (r *ContractRepository) UpdateProjectContract(contract *models.ProjectContract) error {
+	return r.db.Omit("Contract").Save(contract).Error
+}
+
+// DeleteProjectContract deletes a declaration; the shared Contract row is kept
+func (r *ContractRepository) DeleteProjectContract(id uint) error {
+	return r.db.Delete(&models.ProjectContract{}, id).Error
+}
+
+// FindOrCreateContract returns the analytics contract with the given address, creating it under
+// that address when the indexer has not seen it yet
+func (r *ContractRepository) FindOrCreateContract(address, name, logo string) (*models.Contract, error) {
+	var contract models.Contract
+	err := r.db.Where("lower(address) = ?", address).First(&contract).Error
+	if err == nil {
+		return &contract, nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	contract = models.Contract{ID: address, Address: address, Name: name, Logo: logo}
+	if err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&contract).Error; err != nil {
+		return nil, err
+	}
+	// Another request may have created it first
+	err = r.db.Where("lower(address) = ?", address).First(&contract).Error
+	if err != nil {
+		return nil, err
+	}
+	return &contract, nil
+}
+
+// GetContractStats retrieves the aggregated statistics of the given contracts
+func (r *ContractRepository) GetContractStats(contractIDs []string) ([]models.ContractStats, error) {
+	var stats []models.ContractStats
+	if len(contractIDs) == 0 {
+		return stats, nil
+	}
+	err := r.db.Where("contract_id IN ?", contractIDs).Find(&stats).Error
+	return stats, err
+}
+
+// GetActivity buckets the transactions sent to the given addresses since the given time.
+// Interval is a date_trunc unit ("hour", "day" or "week"); buckets are in UTC.
+func (r *ContractRepository) GetActivity(addresses []string, since *time.Time, interval string) ([]ActivityPoint, error) {
+	points := []ActivityPoint{}
+	if len(addresses) == 0 {
+		return points, nil
+	}
+
+	query := r.db.Model(&models.Transaction{}).
+		Select(`date_trunc(?, timestamp AT TIME ZONE 'UTC') AS bucket,
+			COUNT(*) AS tx_count,
+			COUNT(DISTINCT lower(from_address)) AS unique_wallets,
+			COALESCE(SUM(gas_used), 0) AS gas_used`, interval).
+		Where("lower(to_address) IN ?", addresses)
+	if since != nil {
+		query = query.Where("timestamp >= ?", *since)
+	}
+
+	err := query.Group("bucket").Order("bucket ASC").Scan(&points).Error
+	return points, err
+}
+
+// GetActivityTotals aggregates the transactions sent to the given addresses since the given time.
+// Unique wallets are counted over the whole period, not summed per bucket.
+func (r *ContractRepository) GetActivityTotals(addresses []string, since *time.Time) (*ActivityPoint, error) {
+	var totals ActivityPoint
+	if len(addresses) == 0 {
+		return &totals, nil
+	}
+
+	query := r.db.Model(&models.Transaction{}).
+		Select(`COUNT(*) AS tx_count,
+			COUNT(DISTINCT lower(from_address)) AS unique_wallets,
+			COALESCE(SUM(gas_used), 0) AS gas_used`).
+		Where("lower(to_address) IN ?", addresses)
+	if since != nil {
+		query = query.Where("timestamp >= ?", *since)
+	}
+
+	err := query.Scan(&totals).Error
+	return &totals, err
+}
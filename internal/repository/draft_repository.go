@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"time"
+
+	"monad-devhub-be/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type DraftRepository struct {
+	db *gorm.DB
+}
+
+func NewDraftRepository(db *gorm.DB) *DraftRepository {
+	return &DraftRepository{db: db}
+}
+
+// WithTx returns a copy of the repository that runs its queries in tx
+func (r *DraftRepository) WithTx(tx *gorm.DB) *DraftRepository {
+	return &DraftRepository{db: tx}
+}
+
+// Transaction runs fn inside a database transaction (a savepoint if already in one)
+func (r *DraftRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
+
+// CreateDraft creates a new submission draft
+func (r *DraftRepository) CreateDraft(draft *models.SubmissionDraft) error {
+	return r.db.Create(draft).Error
+}
+
+// GetActiveDraftByID retrieves a draft by ID, ignoring drafts that have already expired
+func (r *DraftRepository) GetActiveDraftByID(draftID string) (*models.SubmissionDraft, error) {
+	var draft models.SubmissionDraft
+	err := r.db.Where("id = ? AND expires_at > ?", draftID, time.Now()).First(&draft).Error
+	if err != nil {
+		return nil, err
+	}
+	return &draft, nil
+}
+
+// UpdateDraft updates an existing draft
+func (r *DraftRepository) UpdateDraft(draft *models.SubmissionDraft) error {
+	return r.db.Save(draft).Error
+}
+
+// MarkDraftFinalized records the submission an active draft was finalized as. It reports false when the draft
+// had already been finalized (or has expired), so concurrent finalizations of one draft cannot both succeed
+func (r *DraftRepository) MarkDraftFinalized(draftID, submissionID string) (bool, error) {
+	result := r.db.Model(&models.SubmissionDraft{}).
+		Where("id = ? AND finalized_submission_id IS NULL AND expires_at > ?", draftID, time.Now()).
+		Update("finalized_submission_id", submissionID)
+	return result.RowsAffected == 1, result.Error
+}
+
+// DeleteExpiredDrafts removes all drafts that expired before the given time
+func (r *DraftRepository) DeleteExpiredDrafts(before time.Time) (int64, error) {
+	result := r.db.Where("expires_at <= ?", before).Delete(&models.SubmissionDraft{})
+	return result.RowsAffected, result.Error
+}
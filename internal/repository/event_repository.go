@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"monad-devhub-be/internal/models"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+type EventRepository struct {
+	db *gorm.DB
+}
+
+func NewEventRepository(db *gorm.DB) *EventRepository {
+	return &EventRepository{db: db}
+}
+
+// GetEvents retrieves events ordered by start date, newest first; drafts only when requested
+func (r *EventRepository) GetEvents(includeDrafts bool) ([]models.Event, error) {
+	var events []models.Event
+	query := r.db.Model(&models.Event{})
+	if !includeDrafts {
+		query = query.Where("status <> ?", "draft")
+	}
+	err := query.Order("start_date DESC NULLS LAST, id ASC").Find(&events).Error
+	return events, err
+}
+
+// GetEventByID retrieves an event by ID
+func (r *EventRepository) GetEventByID(id uint) (*models.Event, error) {
+	var event models.Event
+	err := r.db.First(&event, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// GetEventBySlug retrieves an event by slug
+func (r *EventRepository) GetEventBySlug(slug string) (*models.Event, error) {
+	var event models.Event
+	err := r.db.Where("slug = ?", slug).First(&event).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// GetEventByName retrieves an event by its display name
+func (r *EventRepository) GetEventByName(name string) (*models.Event, error) {
+	var event models.Event
+	err := r.db.Where("name = ?", name).First(&event).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// CreateEvent creates a new event
+func (r *EventRepository) CreateEvent(event *models.Event) error {
+	return r.db.Create(event).Error
+}
+
+// UpdateEvent saves an event; when it was renamed, the event names stored on projects and submissions are updated too.
+// Awards, rubrics and judge assignments only store the event ID. The public extra fields of the event's projects
+// are recomputed to hold only the given fields
+func (r *EventRepository) UpdateEvent(event *models.Event, previousName string, publicFields []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(event).Error; err != nil {
+			return err
+		}
+
+		err := tx.Model(&models.Project{}).Unscoped().Where("event_id = ?", event.ID).
+			UpdateColumn("public_extra_fields", gorm.Expr(
+				"(SELECT jsonb_object_agg(key, value) FROM jsonb_each(extra_fields) WHERE key = ANY(?))",
+				pq.StringArray(publicFields),
+			)).Error
+		if err != nil {
+			return err
+		}
+		if event.Name == previousName {
+			return nil
+		}
+
+		if err := tx.Model(&models.Project{}).Unscoped().Where("event_id = ?", event.ID).Update("event", event.Name).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Submission{}).Where("event_id = ?", event.ID).Update("event", event.Name).Error
+	})
+}
+
+// CountEventReferences counts the projects, submissions and awards linked to an event
+func (r *EventRepository) CountEventReferences(id uint) (int64, error) {
+	var projects, submissions, awards int64
+	if err := r.db.Model(&models.Project{}).Unscoped().Where("event_id = ?", id).Count(&projects).Error; err != nil {
+		return 0, err
+	}
+	if err := r.db.Model(&models.Submission{}).Where("event_id = ?", id).Count(&submissions).Error; err != nil {
+		return 0, err
+	}
+	if err := r.db.Model(&models.Award{}).Where("event_id = ?", id).Count(&awards).Error; err != nil {
+		return 0, err
+	}
+	return projects + submissions + awards, nil
+}
+
+// DeleteEvent deletes an event together with its judging setup
+func (r *EventRepository) DeleteEvent(event *models.Event) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("event_id = ?", event.ID).Delete(&models.JudgingCriterion{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("event_id = ?", event.ID).Delete(&models.JudgeAssignment{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Event{}, event.ID).Error
+	})
+}
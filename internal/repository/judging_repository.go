@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"monad-devhub-be/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type JudgingRepository struct {
+	db *gorm.DB
+}
+
+func NewJudgingRepository(db *gorm.DB) *JudgingRepository {
+	return &JudgingRepository{db: db}
+}
+
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *JudgingRepository) WithTx(tx *gorm.DB) *JudgingRepository {
+	return &JudgingRepository{db: tx}
+}
+
+// Transaction runs fn inside a database transaction (a savepoint if already in one)
+func (r *JudgingRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
+
+// CreateJudge creates a judge account
+func (r *JudgingRepository) CreateJudge(judge *models.AdminUser) error {
+	return r.db.Create(judge).Error
+}
+
+// GetJudgeByID retrieves an active judge account
+func (r *JudgingRepository) GetJudgeByID(id uint) (*models.AdminUser, error) {
+	var judge models.AdminUser
+	err := r.db.Where("role = ? AND is_active = ?", "judge", true).First(&judge, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &judge, nil
+}
+
+// GetJudges retrieves all active judge accounts
+func (r *JudgingRepository) GetJudges() ([]models.AdminUser, error) {
+	var judges []models.AdminUser
+	err := r.db.Where("role = ? AND is_active = ?", "judge", true).Order("username ASC").Find(&judges).Error
+	return judges, err
+}
+
+// GetAssignments retrieves the event assignments of the given judges with their events, ordered by event name
+func (r *JudgingRepository) GetAssignments(judgeIDs []uint) ([]models.JudgeAssignment, error) {
+	var assignments []models.JudgeAssignment
+	err := r.db.Joins("EventRecord").
+		Where("judge_assignments.judge_id IN ?", judgeIDs).
+		Order(`"EventRecord"."name" ASC`).
+		Find(&assignments).Error
+	return assignments, err
+}
+
+// IsAssigned reports whether the judge may score projects of the event
+func (r *JudgingRepository) IsAssigned(judgeID, eventID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.JudgeAssignment{}).Where("judge_id = ? AND event_id = ?", judgeID, eventID).Count(&count).Error
+	return count > 0, err
+}
+
+// ReplaceAssignments sets the events a judge is assigned to
+func (r *JudgingRepository) ReplaceAssignments(judgeID uint, eventIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("judge_id = ?", judgeID).Delete(&models.JudgeAssignment{}).Error; err != nil {
+			return err
+		}
+		for _, eventID := range eventIDs {
+			if err := tx.Create(&models.JudgeAssignment{JudgeID: judgeID, EventID: eventID}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetCriteria retrieves the rubric of an event in display order
+func (r *JudgingRepository) GetCriteria(eventID uint) ([]models.JudgingCriterion, error) {
+	var criteria []models.JudgingCriterion
+	err := r.db.Where("event_id = ?", eventID).Order("display_order ASC, id ASC").Find(&criteria).Error
+	return criteria, err
+}
+
+// SaveCriterion creates or updates a rubric criterion
+func (r *JudgingRepository) SaveCriterion(criterion *models.JudgingCriterion) error {
+	return r.db.Save(criterion).Error
+}
+
+// DeleteCriteria deletes rubric criteria by ID
+func (r *JudgingRepository) DeleteCriteria(ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.Delete(&models.JudgingCriterion{}, ids).Error
+}
+
+// CountScoresForCriteria counts the scores recorded against the given criteria
+func (r *JudgingRepository) CountScoresForCriteria(ids []uint) (int64, error) {
+	var count int64
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	err := r.db.Model(&models.JudgeScore{}).Where("criterion_id IN ?", ids).Count(&count).Error
+	return count, err
+}
+
+// UpsertScores stores scores, replacing earlier scores by the same judge for the same project and criterion
+func (r *JudgingRepository) UpsertScores(scores []models.JudgeScore) error {
+	if len(scores) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "judge_id"}, {Name: "project_id"}, {Name: "criterion_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"score", "comment", "updated_at"}),
+	}).Create(&scores).Error
+}
+
+// GetScoresByJudge retrieves a judge's scores for the given projects
+func (r *JudgingRepository) GetScoresByJudge(judgeID uint, projectIDs []uint) ([]models.JudgeScore, error) {
+	var scores []models.JudgeScore
+	err := r.db.Where("judge_id = ? AND project_id IN ?", judgeID, projectIDs).Find(&scores).Error
+	return scores, err
+}
+
+// GetScoresForCriteria retrieves every score recorded against an event's criteria
+func (r *JudgingRepository) GetScoresForCriteria(criterionIDs []uint) ([]models.JudgeScore, error) {
+	var scores []models.JudgeScore
+	if len(criterionIDs) == 0 {
+		return scores, nil
+	}
+	err := r.db.Where("criterion_id IN ?", criterionIDs).Find(&scores).Error
+	return scores, err
+}
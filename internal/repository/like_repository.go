@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"strconv"
+
+	"monad-devhub-be/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LikeRepository struct {
+	db *gorm.DB
+}
+
+func NewLikeRepository(db *gorm.DB) *LikeRepository {
+	return &LikeRepository{db: db}
+}
+
+// WithTx returns a copy of the repository that runs its queries in tx
+func (r *LikeRepository) WithTx(tx *gorm.DB) *LikeRepository {
+	return &LikeRepository{db: tx}
+}
+
+// Transaction runs fn inside a database transaction (a savepoint if already in one)
+func (r *LikeRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
+
+// LockIPLikes takes a transaction-scoped advisory lock on a project's likes from one IP address, so the per-IP
+// limit check and insert of concurrent likes from the same network run one after another.
+// It must be called inside a transaction
+func (r *LikeRepository) LockIPLikes(projectID uint, ipHash string) error {
+	key := "like:" + strconv.FormatUint(uint64(projectID), 10) + ":" + ipHash
+	return r.db.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", key).Error
+}
+
+// AddLike records a like and counts it on its project in the same transaction.
+// It reports false when the voter had already liked the project.
+func (r *LikeRepository) AddLike(like *models.ProjectLike) (bool, error) {
+	added := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(like)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		added = true
+		return adjustLikeCount(tx, like.ProjectID, 1)
+	})
+	return added, err
+}
+
+// RemoveLike deletes a voter's like and uncounts it in the same transaction.
+// It reports false when the voter had not liked the project.
+func (r *LikeRepository) RemoveLike(projectID uint, voterKey string) (bool, error) {
+	removed := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("project_id = ? AND voter_key = ?", projectID, voterKey).Delete(&models.ProjectLike{})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		removed = true
+		return adjustLikeCount(tx, projectID, -1)
+	})
+	return removed, err
+}
+
+// GetLikeCount returns a project's likes counter
+func (r *LikeRepository) GetLikeCount(projectID uint) (int, error) {
+	var likes int
+	err := r.db.Model(&models.Project{}).Where("id = ?", projectID).Select("likes").Scan(&likes).Error
+	return likes, err
+}
+
+// GetLikedProjectIDs returns which of the given projects the voter has liked
+func (r *LikeRepository) GetLikedProjectIDs(voterKey string, projectIDs []uint) (map[uint]bool, error) {
+	liked := make(map[uint]bool)
+	if voterKey == "" || len(projectIDs) == 0 {
+		return liked, nil
+	}
+
+	var ids []uint
+	err := r.db.Model(&models.ProjectLike{}).
+		Where("voter_key = ? AND project_id IN ?", voterKey, projectIDs).
+		Pluck("project_id", &ids).Error
+	for _, id := range ids {
+		liked[id] = true
+	}
+	return liked, err
+}
+
+// CountLikesFromIP counts the anonymous likes a project received from one IP address
+func (r *LikeRepository) CountLikesFromIP(projectID uint, ipHash string) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.ProjectLike{}).
+		Where("project_id = ? AND ip_hash = ? AND voter_type <> 'account'", projectID, ipHash).
+		Count(&count).Error
+	return count, err
+}
+
+// ReconcileLikeCounts recomputes every project's likes from its legacy likes and recorded likes,
+// returning how many counters were wrong
+func (r *LikeRepository) ReconcileLikeCounts() (int64, error) {
+	result := r.db.Exec(`
+		UPDATE projects SET likes = counted.total
+		FROM (
+			SELECT projects.id, projects.legacy_likes + COUNT(project_likes.id) AS total
+			FROM projects
+			LEFT JOIN project_likes ON project_likes.project_id = projects.id
+			GROUP BY projects.id
+		) counted
+		WHERE projects.id = counted.id AND projects.likes IS DISTINCT FROM counted.total`)
+	return result.RowsAffected, result.Error
+}
+
+// adjustLikeCount moves a project's likes counter by delta
+func adjustLikeCount(tx *gorm.DB, projectID uint, delta int) error {
+	return tx.Model(&models.Project{}).Where("id = ?", projectID).
+		UpdateColumn("likes", gorm.Expr("GREATEST(likes + ?, 0)", delta)).Error
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"time"
+
+	"monad-devhub-be/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type MediaRepository struct {
+	db *gorm.DB
+}
+
+func NewMediaRepository(db *gorm.DB) *MediaRepository {
+	return &MediaRepository{db: db}
+}
+
+// GetProjectMedia retrieves a project's gallery in display order
+func (r *MediaRepository) GetProjectMedia(projectID uint) ([]models.ProjectMedia, error) {
+	var media []models.ProjectMedia
+	err := r.db.Where("project_id = ?", projectID).Scopes(mediaOrder).Find(&media).Error
+	return media, err
+}
+
+// GetProjectMediaByID retrieves one gallery item of a project
+func (r *MediaRepository) GetProjectMediaByID(projectID, id uint) (*models.ProjectMedia, error) {
+	var media models.ProjectMedia
+	err := r.db.Where("project_id = ?", projectID).First(&media, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &media, nil
+}
+
+// CreateProjectMedia appends an item to the end of a project's gallery
+func (r *MediaRepository) CreateProjectMedia(media *models.ProjectMedia) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var next int
+		err := tx.Model(&models.ProjectMedia{}).
+			Where("project_id = ?", media.ProjectID).
+			Select("COALESCE(MAX(display_order) + 1, 0)").
+			Scan(&next).Error
+		if err != nil {
+			return err
+		}
+		media.DisplayOrder = next
+		return tx.Create(media).Error
+	})
+}
+
+// UpdateDisplayOrder stores the position of each gallery item in the given order
+func (r *MediaRepository) UpdateDisplayOrder(projectID uint, ids []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			err := tx.Model(&models.ProjectMedia{}).
+				Where("id = ? AND project_id = ?", id, projectID).
+				Update("display_order", i).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteProjectMedia deletes a gallery item
+func (r *MediaRepository) DeleteProjectMedia(id uint) error {
+	return r.db.Delete(&models.ProjectMedia{}, id).Error
+}
+
+// CreateUpload records an uploaded submission image
+func (r *MediaRepository) CreateUpload(upload *models.Upload) error {
+	return r.db.Create(upload).Error
+}
+
+// GetExpiredUploads retrieves up to limit unclaimed uploads created before the given time, oldest first
+func (r *MediaRepository) GetExpiredUploads(before time.Time, limit int) ([]models.Upload, error) {
+	var uploads []models.Upload
+	err := r.db.Where("claimed_at IS NULL AND created_at < ?", before).
+		Order("created_at ASC").Limit(limit).Find(&uploads).Error
+	return uploads, err
+}
+
+// IsUploadReferenced reports whether a submission, draft, project logo or team member photo uses the URL
+func (r *MediaRepository) IsUploadReferenced(url string) (bool, error) {
+	var referenced bool
+	err := r.db.Raw(`
+		SELECT EXISTS (SELECT 1 FROM submissions WHERE photo_link = ?)
+			OR EXISTS (SELECT 1 FROM submission_drafts WHERE strpos(data::text, ?) > 0)
+			OR EXISTS (SELECT 1 FROM projects WHERE logo = ?)
+			OR EXISTS (SELECT 1 FROM team_members WHERE image = ?)`,
+		url, url, url, url).Scan(&referenced).Error
+	return referenced, err
+}
+
+// ClaimUpload marks an upload as referenced, so it is kept
+func (r *MediaRepository) ClaimUpload(id uint) error {
+	return r.db.Model(&models.Upload{}).Where("id = ?", id).Update("claimed_at", time.Now()).Error
+}
+
+// DeleteUpload deletes the record of an upload
+func (r *MediaRepository) DeleteUpload(id uint) error {
+	return r.db.Delete(&models.Upload{}, id).Error
+}
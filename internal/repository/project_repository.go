@@ -1,9 +1,15 @@
 package repository
 
 import (
+	"fmt"
+	"time"
+
 	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/pagination"
 
+	"github.com/lib/pq"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ProjectRepository struct {
@@ -14,52 +20,178 @@ func NewProjectRepository(db *gorm.DB) *ProjectRepository {
 	return &ProjectRepository{db: db}
 }
 
-// GetProjects retrieves projects with pagination and filtering
-func (r *ProjectRepository) GetProjects(offset, limit int, categories []string, event, award, search, sortBy, sortOrder string) ([]models.Project, error) {
-	query := r.db.Preload("TeamMembers")
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *ProjectRepository) WithTx(tx *gorm.DB) *ProjectRepository {
+	return &ProjectRepository{db: tx}
+}
+
+// publishedScope restricts a query to projects that are publicly visible
+func publishedScope(db *gorm.DB) *gorm.DB {
+	return db.Where("projects.published_at IS NOT NULL AND projects.published_at <= ?", time.Now())
+}
+
+// awardScope restricts a query to projects that have been granted an award with the given name
+func awardScope(award string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(`EXISTS (
+			SELECT 1 FROM project_awards
+			JOIN awards ON awards.id = project_awards.award_id
+			WHERE project_awards.project_id = projects.id AND awards.name = ?)`, award)
+	}
+}
+
+// mediaOrder sorts a project's gallery
+func mediaOrder(db *gorm.DB) *gorm.DB {
+	return db.Order("display_order ASC, id ASC")
+}
+
+// contractOrder sorts a project's contract declarations
+func contractOrder(db *gorm.DB) *gorm.DB {
+	return db.Order("id ASC")
+}
+
+// onchainActivityExpr sums the transaction counts of a project's verified contracts
+const onchainActivityExpr = `(SELECT COALESCE(SUM(contract_stats.tx_count), 0)
+	FROM project_contracts
+	JOIN contract_stats ON contract_stats.contract_id = project_contracts.contract_id
+	WHERE project_contracts.project_id = projects.id AND project_contracts.status = 'verified')`
+
+// searchQueryExpr parses a search the way web search boxes do: quoted phrases, "or" and -exclusions
+const searchQueryExpr = "websearch_to_tsquery('english', ?)"
+
+// searchScope matches projects whose search document contains the search terms, falling back to
+// trigram similarity so that misspelled project names still match
+func searchScope(search string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("(projects.search_vector @@ "+searchQueryExpr+" OR ? <% projects.name)", search, search)
+	}
+}
+
+// searchRankExpr scores how well a project matches a search: full-text rank plus how closely the name matches
+const searchRankExpr = "ts_rank(projects.search_vector, " + searchQueryExpr + ") + word_similarity(?, projects.name)"
+
+// projectSortFields whitelists the fields public project lists can be sorted by.
+// Snake-case names are accepted for clients written against the old column-name sorting
+func projectSortFields(search string) pagination.Fields {
+	createdAt := pagination.Field{SQL: "projects.created_at", Kind: pagination.Time, DefaultDesc: true}
+	publishedAt := pagination.Field{SQL: "projects.published_at", Kind: pagination.Time, DefaultDesc: true}
+	fields := pagination.Fields{
+		"id":              {SQL: "projects.id", Kind: pagination.Int, DefaultDesc: true},
+		"name":            {SQL: "projects.name", Kind: pagination.String},
+		"createdAt":       createdAt,
+		"created_at":      createdAt,
+		"publishedAt":     publishedAt,
+		"published_at":    publishedAt,
+		"likes":           {SQL: "COALESCE(projects.likes, 0)", Kind: pagination.Int, DefaultDesc: true},
+		"comments":        {SQL: "COALESCE(projects.comments, 0)", Kind: pagination.Int, DefaultDesc: true},
+		"onchainActivity": {SQL: onchainActivityExpr + "::bigint", Kind: pagination.Int, DefaultDesc: true},
+	}
+	if search != "" {
+		fields["relevance"] = pagination.Field{
+			SQL: searchRankExpr, Vars: []interface{}{search, search}, Kind: pagination.Float, DefaultDesc: true,
+		}
+	}
+	return fields
+}
+
+// SearchHighlightRow is a project's name and description snippet with the matched terms between startSel and stopSel
+type SearchHighlightRow struct {
+	ID          uint
+	Name        string
+	Description string
+}
+
+// GetSearchHighlights marks the search terms in the name and in up to two description fragments of each project
+func (r *ProjectRepository) GetSearchHighlights(ids []uint, search, startSel, stopSel string) ([]SearchHighlightRow, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	// The selectors are removed from the text first, so only the marks added here can appear in the result
+	selectors := fmt.Sprintf(`StartSel="%s", StopSel="%s"`, startSel, stopSel)
+	var rows []SearchHighlightRow
+	err := r.db.Model(&models.Project{}).
+		Select(
+			"id, ts_headline('english', translate(name, ?, ''), "+searchQueryExpr+", ?) AS name, ts_headline('english', translate(description, ?, ''), "+searchQueryExpr+", ?) AS description",
+			startSel+stopSel, search, selectors+", HighlightAll=true",
+			startSel+stopSel, search, selectors+`, MaxFragments=2, MaxWords=25, MinWords=8, FragmentDelimiter=" … "`,
+		).
+		Where("id IN ?", ids).
+		Scan(&rows).Error
+	return rows, err
+}
+
+// GetProjects retrieves a page of published projects with filtering, and the cursors of the pages either side.
+// Searches are sorted by relevance unless another sort is requested
+// SortBy "onchainActivity" ranks projects by the transactions of their verified contracts
+func (r *ProjectRepository) GetProjects(page pagination.Request, categories []string, event, award, search string) ([]models.Project, pagination.Cursors, error) {
+	fallback := "createdAt:desc"
+	if search != "" {
+		fallback = "relevance:desc,createdAt:desc"
+	}
+	sort, err := pagination.ParseSort(page.SortBy, page.SortOrder, projectSortFields(search), fallback)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+	cursor, err := sort.DecodeCursor(page.Cursor)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	query := r.db.Preload("TeamMembers").Preload("Awards.Award", awardEventScope).Scopes(publishedScope)
 
 	// Apply filters
 	if len(categories) > 0 {
-		query = query.Where("categories && ?", categories)
+		query = query.Where("categories && ?", pq.StringArray(categories))
 	}
 	if event != "" {
 		query = query.Where("event = ?", event)
 	}
 	if award != "" {
-		query = query.Where("award = ?", award)
+		query = query.Scopes(awardScope(award))
 	}
 	if search != "" {
-		query = query.Where("name ILIKE ? OR description ILIKE ?", "%"+search+"%", "%"+search+"%")
+		query = query.Scopes(searchScope(search))
 	}
 
-	// Apply sorting
-	if sortBy != "" && sortOrder != "" {
-		query = query.Order(sortBy + " " + sortOrder)
-	} else {
-		query = query.Order("created_at DESC")
+	// Apply sorting, and the cursor position or offset
+	query = sort.Apply(query, cursor)
+	if cursor == nil {
+		query = query.Offset(page.Offset)
 	}
 
 	var projects []models.Project
-	err := query.Offset(offset).Limit(limit).Find(&projects).Error
-	return projects, err
+	if err := query.Limit(page.Limit + 1).Find(&projects).Error; err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+	projects, more := pagination.Trim(projects, page.Limit, cursor)
+
+	cursors, err := sort.Cursors(cursor, page.Offset, len(projects), more, func(last bool) ([]interface{}, error) {
+		id := projects[0].ID
+		if last {
+			id = projects[len(projects)-1].ID
+		}
+		return sort.Values(r.db.Unscoped().Model(&models.Project{}).Where("projects.id = ?", id))
+	})
+	return projects, cursors, err
 }
 
-// GetProjectsCount returns total count with filters
+// GetProjectsCount returns total count of published projects with filters
 func (r *ProjectRepository) GetProjectsCount(categories []string, event, award, search string) (int64, error) {
-	query := r.db.Model(&models.Project{})
+	query := r.db.Model(&models.Project{}).Scopes(publishedScope)
 
 	// Apply same filters as GetProjects
 	if len(categories) > 0 {
-		query = query.Where("categories && ?", categories)
+		query = query.Where("categories && ?", pq.StringArray(categories))
 	}
 	if event != "" {
 		query = query.Where("event = ?", event)
 	}
 	if award != "" {
-		query = query.Where("award = ?", award)
+		query = query.Scopes(awardScope(award))
 	}
 	if search != "" {
-		query = query.Where("name ILIKE ? OR description ILIKE ?", "%"+search+"%", "%"+search+"%")
+		query = query.Scopes(searchScope(search))
 	}
 
 	var count int64
@@ -67,27 +199,125 @@ func (r *ProjectRepository) GetProjectsCount(categories []string, event, award,
 	return count, err
 }
 
-// GetProjectByID retrieves a project by ID with team members
+// GetPublishedProjectByID retrieves a publicly visible project by ID with team members
+func (r *ProjectRepository) GetPublishedProjectByID(id uint) (*models.Project, error) {
+	var project models.Project
+	err := r.db.Preload("TeamMembers").Preload("Awards.Award", awardEventScope).Preload("Media", mediaOrder).
+		Preload("Contracts", verifiedContractsScope, contractOrder).
+		Scopes(publishedScope).First(&project, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// GetUnpublishedProjects retrieves approved projects that are not yet publicly visible
+func (r *ProjectRepository) GetUnpublishedProjects() ([]models.Project, error) {
+	var projects []models.Project
+	err := r.db.Preload("TeamMembers").Preload("Awards.Award", awardEventScope).
+		Where("published_at IS NULL OR published_at > ?", time.Now()).
+		Order("publish_at ASC NULLS LAST").
+		Find(&projects).Error
+	return projects, err
+}
+
+// GetProjectsDueForPublishing retrieves scheduled projects whose publish time has passed
+func (r *ProjectRepository) GetProjectsDueForPublishing(now time.Time) ([]models.Project, error) {
+	var projects []models.Project
+	err := r.db.Preload("TeamMembers").Preload("Awards.Award", awardEventScope).
+		Where("published_at IS NULL AND publish_at IS NOT NULL AND publish_at <= ?", now).
+		Order("publish_at ASC").
+		Find(&projects).Error
+	return projects, err
+}
+
+// MarkProjectPublished makes an unpublished project visible as of now. With dueOnly, only a project whose
+// scheduled publish time has passed is published. It reports false when the project was not published by this
+// call, e.g. because another scheduler instance got to it first
+func (r *ProjectRepository) MarkProjectPublished(id uint, now time.Time, dueOnly bool) (bool, error) {
+	query := r.db.Model(&models.Project{}).Where("id = ? AND published_at IS NULL", id)
+	if dueOnly {
+		query = query.Where("publish_at IS NOT NULL AND publish_at <= ?", now)
+	}
+	result := query.Updates(map[string]interface{}{"published_at": now, "publish_at": nil})
+	return result.RowsAffected == 1, result.Error
+}
+
+// featuredNowScope restricts a query to projects whose featured window includes now
+func featuredNowScope(db *gorm.DB) *gorm.DB {
+	now := time.Now()
+	return db.Where("projects.featured AND (projects.featured_from IS NULL OR projects.featured_from <= ?) AND (projects.featured_until IS NULL OR projects.featured_until > ?)", now, now)
+}
+
+// GetFeaturedProjects retrieves the published projects featured right now in carousel order
+func (r *ProjectRepository) GetFeaturedProjects() ([]models.Project, error) {
+	var projects []models.Project
+	err := r.db.Preload("TeamMembers").Preload("Awards.Award", awardEventScope).
+		Scopes(publishedScope, featuredNowScope).
+		Order("featured_order ASC, published_at DESC").
+		Find(&projects).Error
+	return projects, err
+}
+
+// GetFlaggedFeaturedProjects retrieves every project flagged as featured, including scheduled, expired and unpublished ones
+func (r *ProjectRepository) GetFlaggedFeaturedProjects() ([]models.Project, error) {
+	var projects []models.Project
+	err := r.db.Preload("TeamMembers").
+		Where("featured").
+		Order("featured_order ASC, featured_from ASC NULLS FIRST, id ASC").
+		Find(&projects).Error
+	return projects, err
+}
+
+// UpdateFeatured sets a project's featured flag, position and window without changing its version
+func (r *ProjectRepository) UpdateFeatured(id uint, featured bool, order int, from, until *time.Time) error {
+	result := r.db.Model(&models.Project{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+		"featured":       featured,
+		"featured_order": order,
+		"featured_from":  from,
+		"featured_until": until,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetProjectByID retrieves a project by ID with team members, including unpublished ones
 func (r *ProjectRepository) GetProjectByID(id uint) (*models.Project, error) {
 	var project models.Project
-	err := r.db.Preload("TeamMembers").First(&project, id).Error
+	err := r.db.Preload("TeamMembers").Preload("Awards.Award", awardEventScope).Preload("Media", mediaOrder).
+		Preload("Contracts", contractOrder).First(&project, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &project, nil
 }
 
-// CreateProject creates a new project
+// CreateProject creates a new project, linking its team members to their builders
 func (r *ProjectRepository) CreateProject(project *models.Project) error {
-	return r.db.Create(project).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := linkBuilders(tx, project.TeamMembers); err != nil {
+			return err
+		}
+		return tx.Create(project).Error
+	})
 }
 
 // UpdateProject updates an existing project and its team members
 func (r *ProjectRepository) UpdateProject(project *models.Project) error {
 	// Use a transaction to ensure atomicity
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		// Update the project itself (excluding team members to handle them separately)
-		if err := tx.Omit("TeamMembers").Save(project).Error; err != nil {
+		// Update the project itself (excluding team members to handle them separately; awards, media and contracts have their own repositories)
+		if err := tx.Omit("TeamMembers", "Awards", "Media", "Contracts").Save(project).Error; err != nil {
+			return err
+		}
+
+		// Team members are linked to the builder of their current handle before they are saved
+		if err := linkBuilders(tx, project.TeamMembers); err != nil {
 			return err
 		}
 
@@ -112,28 +342,237 @@ func (r *ProjectRepository) GetProjectByName(name string) (*models.Project, erro
 	return &project, nil
 }
 
-// IncrementLikes increments the likes count for a project
-func (r *ProjectRepository) IncrementLikes(id uint) error {
-	return r.db.Model(&models.Project{}).Where("id = ?", id).UpdateColumn("likes", gorm.Expr("likes + ?", 1)).Error
+// GetProjectsByEvent retrieves every project of an event, including unpublished ones
+func (r *ProjectRepository) GetProjectsByEvent(event string) ([]models.Project, error) {
+	var projects []models.Project
+	err := r.db.Preload("Awards.Award", awardEventScope).Where("event = ?", event).Order("name ASC").Find(&projects).Error
+	return projects, err
+}
+
+// GetProjectByExternalID retrieves a project imported from an external platform
+func (r *ProjectRepository) GetProjectByExternalID(source, externalID string) (*models.Project, error) {
+	var project models.Project
+	err := r.db.Where("external_source = ? AND external_id = ?", source, externalID).First(&project).Error
+	if err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// UpdateLogo replaces a project's logo and its thumbnail
+func (r *ProjectRepository) UpdateLogo(id uint, logo, thumbnail string) error {
+	return r.db.Model(&models.Project{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"logo": logo, "logo_thumbnail": thumbnail}).Error
 }
 
-// GetDistinctCategories returns all unique categories
-func (r *ProjectRepository) GetDistinctCategories() ([]string, error) {
-	var categories []string
-	err := r.db.Model(&models.Project{}).Distinct("unnest(categories)").Pluck("unnest", &categories).Error
-	return categories, err
+// UpdateTeamMemberImage replaces a team member's photo and its thumbnail
+func (r *ProjectRepository) UpdateTeamMemberImage(memberID uint, image, thumbnail string) error {
+	return r.db.Model(&models.TeamMember{}).Where("id = ?", memberID).
+		Updates(map[string]interface{}{"image": image, "image_thumbnail": thumbnail}).Error
 }
 
 // GetDistinctEvents returns all unique events
 func (r *ProjectRepository) GetDistinctEvents() ([]string, error) {
 	var events []string
-	err := r.db.Model(&models.Project{}).Distinct("event").Pluck("event", &events).Error
+	err := r.db.Model(&models.Project{}).Scopes(publishedScope).Distinct("event").Pluck("event", &events).Error
 	return events, err
 }
 
-// GetDistinctAwards returns all unique awards
+// GetDistinctAwards returns the names of all awards granted to published projects
 func (r *ProjectRepository) GetDistinctAwards() ([]string, error) {
 	var awards []string
-	err := r.db.Model(&models.Project{}).Distinct("award").Pluck("award", &awards).Error
+	err := r.db.Model(&models.Award{}).
+		Joins("JOIN project_awards ON project_awards.award_id = awards.id").
+		Joins("JOIN projects ON projects.id = project_awards.project_id AND projects.deleted_at IS NULL").
+		Scopes(publishedScope).
+		Distinct("awards.name").
+		Order("awards.name ASC").
+		Pluck("awards.name", &awards).Error
 	return awards, err
 }
+
+// StreamProjects loads all projects, including unpublished ones, in batches and passes each batch to fn
+func (r *ProjectRepository) StreamProjects(batchSize int, fn func(batch []models.Project) error) error {
+	var batch []models.Project
+	return r.db.Preload("TeamMembers").Preload("Awards.Award", awardEventScope).FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+		return fn(batch)
+	}).Error
+}
+
+// GetMaxTeamSize returns the largest number of team members on any project
+func (r *ProjectRepository) GetMaxTeamSize() (int, error) {
+	var maxSize int
+	err := r.db.Raw(`SELECT COALESCE(MAX(member_count), 0) FROM (
+		SELECT COUNT(*) AS member_count FROM team_members
+		JOIN projects ON projects.id = team_members.project_id AND projects.deleted_at IS NULL
+		GROUP BY team_members.project_id
+	) AS team_sizes`).Scan(&maxSize).Error
+	return maxSize, err
+}
+
+// GetDeletedProjects retrieves soft-deleted projects, most recently deleted first
+func (r *ProjectRepository) GetDeletedProjects() ([]models.Project, error) {
+	var projects []models.Project
+	err := r.db.Unscoped().Preload("TeamMembers").
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Find(&projects).Error
+	return projects, err
+}
+
+// GetDeletedProjectByID retrieves a soft-deleted project with its team and gallery
+func (r *ProjectRepository) GetDeletedProjectByID(id uint) (*models.Project, error) {
+	var project models.Project
+	err := r.db.Unscoped().Preload("TeamMembers").Preload("Media", mediaOrder).
+		Where("deleted_at IS NOT NULL").First(&project, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// UpdateProjectIfUnchanged applies an edit to a project unless the project changed since version.
+// A nil version skips the check. It reports false when the project had changed.
+func (r *ProjectRepository) UpdateProjectIfUnchanged(id uint, version *time.Time, edit *ProjectEdit) (bool, error) {
+	updated := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		current, err := lockProject(tx, id, version)
+		if err != nil || !current {
+			return err
+		}
+		updated = true
+		return applyProjectEdit(tx, id, nil, edit)
+	})
+	return updated, err
+}
+
+// AddTeamMember adds a member to a project's team, linked to their builder, unless the project changed since version
+func (r *ProjectRepository) AddTeamMember(member *models.TeamMember, version *time.Time, log *models.ProjectChangeLog) (bool, error) {
+	return r.editTeam(member.ProjectID, version, log, func(tx *gorm.DB) error {
+		if err := linkBuilder(tx, member); err != nil {
+			return err
+		}
+		return tx.Create(member).Error
+	})
+}
+
+// UpdateTeamMember renames a team member or changes their twitter handle, unless the project changed since version.
+// The member is relinked to the builder of their current handle.
+func (r *ProjectRepository) UpdateTeamMember(member *models.TeamMember, version *time.Time, log *models.ProjectChangeLog) (bool, error) {
+	return r.editTeam(member.ProjectID, version, log, func(tx *gorm.DB) error {
+		if err := linkBuilder(tx, member); err != nil {
+			return err
+		}
+		result := tx.Model(&models.TeamMember{}).
+			Where("id = ? AND project_id = ?", member.ID, member.ProjectID).
+			Updates(map[string]interface{}{"name": member.Name, "twitter": member.Twitter, "builder_id": member.BuilderID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// DeleteTeamMember removes a member from a project's team unless the project changed since version
+func (r *ProjectRepository) DeleteTeamMember(projectID, memberID uint, version *time.Time, log *models.ProjectChangeLog) (bool, error) {
+	return r.editTeam(projectID, version, log, func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND project_id = ?", memberID, projectID).Delete(&models.TeamMember{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// SoftDeleteProject hides a project everywhere; it can be restored until it is purged
+func (r *ProjectRepository) SoftDeleteProject(id uint) error {
+	result := r.db.Delete(&models.Project{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// RestoreProject brings back a soft-deleted project
+func (r *ProjectRepository) RestoreProject(id uint) error {
+	result := r.db.Unscoped().Model(&models.Project{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// PurgeProject permanently deletes a soft-deleted project. Its team, gallery, awards, contracts, likes, comments
+// and change history are removed by their foreign keys; judge scores are removed here, and submissions keep
+// their record but lose the link to the project.
+func (r *ProjectRepository) PurgeProject(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Model(&models.Submission{}).
+			Where("approved_project_id = ?", id).
+			UpdateColumn("approved_project_id", nil).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("project_id = ?", id).Delete(&models.JudgeScore{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Where("deleted_at IS NOT NULL").Delete(&models.Project{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// editTeam runs a team change in a transaction that checks the project's version, moves it forward
+// and records the change in the project's change log
+func (r *ProjectRepository) editTeam(projectID uint, version *time.Time, log *models.ProjectChangeLog, change func(tx *gorm.DB) error) (bool, error) {
+	edited := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		current, err := lockProject(tx, projectID, version)
+		if err != nil || !current {
+			return err
+		}
+		edited = true
+
+		if err := tx.Model(&models.Project{}).Where("id = ?", projectID).Update("updated_at", time.Now()).Error; err != nil {
+			return err
+		}
+		if err := change(tx); err != nil {
+			return err
+		}
+
+		log.ProjectID = projectID
+		return tx.Create(log).Error
+	})
+	return edited, err
+}
+
+// lockProject locks a project's row until the transaction ends and reports whether the project is still
+// at version; a nil version always matches
+func lockProject(tx *gorm.DB, id uint, version *time.Time) (bool, error) {
+	var project models.Project
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "updated_at").First(&project, id).Error
+	if err != nil {
+		return false, err
+	}
+	return version == nil || project.UpdatedAt.Equal(*version), nil
+}
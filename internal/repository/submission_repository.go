@@ -1,8 +1,15 @@
 package repository
 
 import (
+	"errors"
+	"strings"
+	"time"
+
 	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/pagination"
+	"monad-devhub-be/internal/utils"
 
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
@@ -14,9 +21,39 @@ func NewSubmissionRepository(db *gorm.DB) *SubmissionRepository {
 	return &SubmissionRepository{db: db}
 }
 
-// CreateSubmission creates a new project submission
+// WithTx returns a copy of the repository bound to the given transaction
+func (r *SubmissionRepository) WithTx(tx *gorm.DB) *SubmissionRepository {
+	return &SubmissionRepository{db: tx}
+}
+
+// Transaction runs fn inside a database transaction (a savepoint if already in one)
+func (r *SubmissionRepository) Transaction(fn func(tx *gorm.DB) error) error {
+	return r.db.Transaction(fn)
+}
+
+// maxSubmissionIDAttempts bounds how often CreateSubmission regenerates a colliding ID
+const maxSubmissionIDAttempts = 5
+
+// CreateSubmission creates a new project submission, regenerating the ID on primary-key conflicts
 func (r *SubmissionRepository) CreateSubmission(submission *models.Submission) error {
-	return r.db.Create(submission).Error
+	for attempt := 1; ; attempt++ {
+		// Each attempt runs in its own (sub)transaction so a conflict does not abort an outer transaction
+		err := r.db.Transaction(func(tx *gorm.DB) error {
+			return tx.Create(submission).Error
+		})
+		if err == nil || !errors.Is(err, gorm.ErrDuplicatedKey) || attempt == maxSubmissionIDAttempts {
+			return err
+		}
+
+		// Only retry when it is the submission ID that collided
+		var existing int64
+		if countErr := r.db.Model(&models.Submission{}).Where("id = ?", submission.ID).Count(&existing).Error; countErr != nil || existing == 0 {
+			return err
+		}
+		if submission.ID, err = utils.GenerateSubmissionID(); err != nil {
+			return err
+		}
+	}
 }
 
 // GetSubmissionByID retrieves a submission by ID
@@ -29,33 +66,124 @@ func (r *SubmissionRepository) GetSubmissionByID(submissionID string) (*models.S
 	return &submission, nil
 }
 
-// GetSubmissions retrieves submissions with pagination and filtering
-func (r *SubmissionRepository) GetSubmissions(offset, limit int, status, sortBy, sortOrder string) ([]models.Submission, error) {
-	query := r.db.Preload("ApprovedProject")
+// SubmissionFilter holds the optional filters for listing submissions
+type SubmissionFilter struct {
+	Status        string
+	Event         string
+	Categories    []string
+	ReviewerID    *uint
+	SubmittedFrom *time.Time
+	SubmittedTo   *time.Time
+	HasGithub     *bool
+	Search        string
+}
+
+// applySubmissionFilters applies every filter except status to the query
+func applySubmissionFilters(query *gorm.DB, filter SubmissionFilter) *gorm.DB {
+	if filter.Event != "" {
+		query = query.Where("event = ?", filter.Event)
+	}
+	if len(filter.Categories) > 0 {
+		query = query.Where("categories && ?", pq.StringArray(filter.Categories))
+	}
+	if filter.ReviewerID != nil {
+		query = query.Where("reviewer_id = ?", *filter.ReviewerID)
+	}
+	if filter.SubmittedFrom != nil {
+		query = query.Where("submitted_at >= ?", *filter.SubmittedFrom)
+	}
+	if filter.SubmittedTo != nil {
+		query = query.Where("submitted_at <= ?", *filter.SubmittedTo)
+	}
+	if filter.HasGithub != nil {
+		if *filter.HasGithub {
+			query = query.Where("github_link IS NOT NULL AND github_link <> ''")
+		} else {
+			query = query.Where("github_link IS NULL OR github_link = ''")
+		}
+	}
+	if search := strings.TrimSpace(filter.Search); search != "" {
+		// Full-text match on name/description, plus substring match on name and team members in the jsonb blob
+		pattern := likePattern(search)
+		handlePattern := likePattern(strings.TrimPrefix(search, "@"))
+		query = query.Where(
+			`to_tsvector('simple', coalesce(project_name, '') || ' ' || coalesce(description, '')) @@ plainto_tsquery('simple', ?)
+			OR project_name ILIKE ? OR description ILIKE ?
+			OR EXISTS (
+				SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof(team_members) = 'array' THEN team_members ELSE '[]'::jsonb END) AS member
+				WHERE member->>'name' ILIKE ? OR ltrim(member->>'twitter', '@') ILIKE ?
+			)`,
+			search, pattern, pattern, pattern, handlePattern,
+		)
+	}
+	return query
+}
+
+// submissionSortFields whitelists the fields submission lists can be sorted by.
+// Snake-case names are accepted for clients written against the old column-name sorting
+func submissionSortFields() pagination.Fields {
+	submittedAt := pagination.Field{SQL: "submissions.submitted_at", Kind: pagination.Time, DefaultDesc: true}
+	updatedAt := pagination.Field{SQL: "submissions.updated_at", Kind: pagination.Time, DefaultDesc: true}
+	projectName := pagination.Field{SQL: "submissions.project_name", Kind: pagination.String}
+	return pagination.Fields{
+		"id":           {SQL: "submissions.id", Kind: pagination.String},
+		"submittedAt":  submittedAt,
+		"submitted_at": submittedAt,
+		"updatedAt":    updatedAt,
+		"updated_at":   updatedAt,
+		"projectName":  projectName,
+		"project_name": projectName,
+		"status":       {SQL: "submissions.status", Kind: pagination.String},
+		"event":        {SQL: "submissions.event", Kind: pagination.String},
+	}
+}
+
+// GetSubmissions retrieves a page of submissions with filtering, and the cursors of the pages either side
+func (r *SubmissionRepository) GetSubmissions(page pagination.Request, filter SubmissionFilter) ([]models.Submission, pagination.Cursors, error) {
+	sort, err := pagination.ParseSort(page.SortBy, page.SortOrder, submissionSortFields(), "submittedAt:desc")
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+	cursor, err := sort.DecodeCursor(page.Cursor)
+	if err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+
+	query := applySubmissionFilters(r.db.Preload("ApprovedProject"), filter)
 
 	// Apply status filter
-	if status != "" {
-		query = query.Where("status = ?", status)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
 	}
 
-	// Apply sorting
-	if sortBy != "" && sortOrder != "" {
-		query = query.Order(sortBy + " " + sortOrder)
-	} else {
-		query = query.Order("submitted_at DESC")
+	// Apply sorting, and the cursor position or offset
+	query = sort.Apply(query, cursor)
+	if cursor == nil {
+		query = query.Offset(page.Offset)
 	}
 
 	var submissions []models.Submission
-	err := query.Offset(offset).Limit(limit).Find(&submissions).Error
-	return submissions, err
+	if err := query.Limit(page.Limit + 1).Find(&submissions).Error; err != nil {
+		return nil, pagination.Cursors{}, err
+	}
+	submissions, more := pagination.Trim(submissions, page.Limit, cursor)
+
+	cursors, err := sort.Cursors(cursor, page.Offset, len(submissions), more, func(last bool) ([]interface{}, error) {
+		id := submissions[0].ID
+		if last {
+			id = submissions[len(submissions)-1].ID
+		}
+		return sort.Values(r.db.Model(&models.Submission{}).Where("submissions.id = ?", id))
+	})
+	return submissions, cursors, err
 }
 
 // GetSubmissionsCount returns total count with filters
-func (r *SubmissionRepository) GetSubmissionsCount(status string) (int64, error) {
-	query := r.db.Model(&models.Submission{})
+func (r *SubmissionRepository) GetSubmissionsCount(filter SubmissionFilter) (int64, error) {
+	query := applySubmissionFilters(r.db.Model(&models.Submission{}), filter)
 
-	if status != "" {
-		query = query.Where("status = ?", status)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
 	}
 
 	var count int64
@@ -78,15 +206,26 @@ func (r *SubmissionRepository) GetSubmissionByProjectName(projectName string) (*
 	return &submission, nil
 }
 
-// GetSubmissionStats returns statistics about submissions
-func (r *SubmissionRepository) GetSubmissionStats() (map[string]int64, error) {
+// GetSubmissionByExternalID retrieves a submission imported from an external platform
+func (r *SubmissionRepository) GetSubmissionByExternalID(source, externalID string) (*models.Submission, error) {
+	var submission models.Submission
+	err := r.db.Where("external_source = ? AND external_id = ?", source, externalID).First(&submission).Error
+	if err != nil {
+		return nil, err
+	}
+	return &submission, nil
+}
+
+// GetSubmissionStats returns statistics about submissions matching the filter, broken down by status
+func (r *SubmissionRepository) GetSubmissionStats(filter SubmissionFilter) (map[string]int64, error) {
 	stats := make(map[string]int64)
 
 	// Count by status
 	statuses := []string{"pending", "under_review", "approved", "rejected", "requires_changes"}
 	for _, status := range statuses {
 		var count int64
-		err := r.db.Model(&models.Submission{}).Where("status = ?", status).Count(&count).Error
+		query := applySubmissionFilters(r.db.Model(&models.Submission{}), filter)
+		err := query.Where("status = ?", status).Count(&count).Error
 		if err != nil {
 			return nil, err
 		}
@@ -95,3 +234,51 @@ func (r *SubmissionRepository) GetSubmissionStats() (map[string]int64, error) {
 
 	return stats, nil
 }
+
+// likePattern escapes LIKE wildcards in user input and wraps it for a substring match
+func likePattern(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + replacer.Replace(value) + "%"
+}
+
+// GetSubmissionsForReviewMetrics returns the review timestamps of submissions submitted since the given time
+func (r *SubmissionRepository) GetSubmissionsForReviewMetrics(since *time.Time, event string) ([]models.Submission, error) {
+	query := r.db.Model(&models.Submission{}).
+		Select("id", "event", "status", "reviewer_id", "submitted_at", "review_started_at", "reviewed_at")
+
+	if since != nil {
+		query = query.Where("submitted_at >= ?", *since)
+	}
+	if event != "" {
+		query = query.Where("event = ?", event)
+	}
+
+	var submissions []models.Submission
+	err := query.Order("submitted_at ASC").Find(&submissions).Error
+	return submissions, err
+}
+
+// StreamSubmissions loads submissions matching the filter in batches and passes each batch to fn
+func (r *SubmissionRepository) StreamSubmissions(filter SubmissionFilter, batchSize int, fn func(batch []models.Submission) error) error {
+	query := applySubmissionFilters(r.db.Model(&models.Submission{}), filter)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var batch []models.Submission
+	return query.FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+		return fn(batch)
+	}).Error
+}
+
+// GetMaxTeamSize returns the largest number of team members on any submission matching the filter
+func (r *SubmissionRepository) GetMaxTeamSize(filter SubmissionFilter) (int, error) {
+	query := applySubmissionFilters(r.db.Model(&models.Submission{}), filter)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var maxSize int
+	err := query.Select("COALESCE(MAX(CASE WHEN jsonb_typeof(team_members) = 'array' THEN jsonb_array_length(team_members) ELSE 0 END), 0)").Scan(&maxSize).Error
+	return maxSize, err
+}
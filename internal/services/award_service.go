@@ -0,0 +1,253 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+type AwardService struct {
+	awardRepo   *repository.AwardRepository
+	projectRepo *repository.ProjectRepository
+	eventRepo   *repository.EventRepository
+}
+
+func NewAwardService(awardRepo *repository.AwardRepository, projectRepo *repository.ProjectRepository, eventRepo *repository.EventRepository) *AwardService {
+	return &AwardService{
+		awardRepo:   awardRepo,
+		projectRepo: projectRepo,
+		eventRepo:   eventRepo,
+	}
+}
+
+// AwardRequest represents the payload for creating or updating an award
+type AwardRequest struct {
+	Name          string   `json:"name" binding:"required"`
+	Event         string   `json:"event"` // Event name or slug; empty for awards that are not tied to an event
+	Track         string   `json:"track"`
+	Rank          *int     `json:"rank" binding:"omitempty,min=1"`
+	PrizeAmount   *float64 `json:"prizeAmount" binding:"omitempty,gte=0"`
+	PrizeCurrency string   `json:"prizeCurrency" binding:"omitempty,max=10"`
+	Sponsor       string   `json:"sponsor"`
+}
+
+// GrantAwardRequest represents the payload for granting an award to a project
+type GrantAwardRequest struct {
+	AwardID uint   `json:"awardId" binding:"required"`
+	Note    string `json:"note"`
+}
+
+// GetAwards lists awards, optionally only those of one event (by name or slug)
+func (s *AwardService) GetAwards(event string) ([]models.Award, error) {
+	var eventID *uint
+	if event != "" {
+		record, err := findEventByNameOrSlug(s.eventRepo, event)
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.New("INVALID_EVENT: Invalid event provided")
+		}
+		if err != nil {
+			return nil, err
+		}
+		eventID = &record.ID
+	}
+	return s.awardRepo.GetAwards(eventID)
+}
+
+// CreateAward creates a new award
+func (s *AwardService) CreateAward(req *AwardRequest) (*models.Award, error) {
+	award := &models.Award{}
+	if err := s.applyAwardRequest(award, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.awardRepo.CreateAward(award); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("DUPLICATE_AWARD: An award with this name already exists in this event track")
+		}
+		return nil, err
+	}
+	return award, nil
+}
+
+// UpdateAward replaces an award's details; existing grants keep pointing at it
+func (s *AwardService) UpdateAward(id uint, req *AwardRequest) (*models.Award, error) {
+	award, err := s.awardRepo.GetAwardByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	previousEventID := award.EventID
+	if err := s.applyAwardRequest(award, req); err != nil {
+		return nil, err
+	}
+	if !sameEventID(previousEventID, award.EventID) {
+		grants, err := s.awardRepo.CountGrants(award.ID)
+		if err != nil {
+			return nil, err
+		}
+		if grants > 0 {
+			return nil, errors.New("AWARD_IN_USE: The event of a granted award cannot be changed")
+		}
+	}
+
+	if err := s.awardRepo.UpdateAward(award); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("DUPLICATE_AWARD: An award with this name already exists in this event track")
+		}
+		return nil, err
+	}
+	return award, nil
+}
+
+// DeleteAward deletes an award that has not been granted to any project
+func (s *AwardService) DeleteAward(id uint) error {
+	if _, err := s.awardRepo.GetAwardByID(id); err != nil {
+		return err
+	}
+
+	grants, err := s.awardRepo.CountGrants(id)
+	if err != nil {
+		return err
+	}
+	if grants > 0 {
+		return errors.New("AWARD_IN_USE: Award has been granted; revoke it from all projects first")
+	}
+
+	return s.awardRepo.DeleteAward(id)
+}
+
+// GrantAward grants an award to a project of the award's event
+func (s *AwardService) GrantAward(projectID uint, req *GrantAwardRequest) (*models.ProjectAward, error) {
+	project, err := s.projectRepo.GetProjectByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	award, err := s.awardRepo.GetAwardByID(req.AwardID)
+	if err == gorm.ErrRecordNotFound {
+		return nil, errors.New("INVALID_AWARD: Award not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if award.EventID != nil && !sameEventID(award.EventID, project.EventID) {
+		return nil, errors.New("AWARD_EVENT_MISMATCH: Award belongs to a different event than the project")
+	}
+
+	grant := &models.ProjectAward{
+		ProjectID: project.ID,
+		AwardID:   award.ID,
+		Note:      strings.TrimSpace(req.Note),
+		GrantedAt: time.Now(),
+	}
+	if err := s.awardRepo.GrantAward(grant); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("ALREADY_GRANTED: Project already has this award")
+		}
+		return nil, err
+	}
+	grant.Award = award
+	return grant, nil
+}
+
+// RevokeAward removes an award from a project
+func (s *AwardService) RevokeAward(projectID, awardID uint) error {
+	revoked, err := s.awardRepo.RevokeAward(projectID, awardID)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// applyAwardRequest validates the request and copies it onto the award
+func (s *AwardService) applyAwardRequest(award *models.Award, req *AwardRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return errors.New("INVALID_AWARD: name must not be empty")
+	}
+	currency := strings.ToUpper(strings.TrimSpace(req.PrizeCurrency))
+	if req.PrizeAmount != nil && currency == "" {
+		return errors.New("INVALID_AWARD: prizeCurrency is required with prizeAmount")
+	}
+
+	award.EventID = nil
+	award.Event = ""
+	if req.Event != "" {
+		event, err := findEventByNameOrSlug(s.eventRepo, req.Event)
+		if err == gorm.ErrRecordNotFound {
+			return errors.New("INVALID_EVENT: Invalid event provided")
+		}
+		if err != nil {
+			return err
+		}
+		award.EventID = &event.ID
+		award.Event = event.Name
+	}
+
+	award.Name = name
+	award.Track = strings.TrimSpace(req.Track)
+	award.Rank = req.Rank
+	award.PrizeAmount = req.PrizeAmount
+	award.PrizeCurrency = currency
+	award.Sponsor = strings.TrimSpace(req.Sponsor)
+	return nil
+}
+
+// grantAwardByName grants the named award of the project's event, creating the award on first use.
+// Granting an award the project already holds is a no-op.
+func grantAwardByName(awardRepo *repository.AwardRepository, project *models.Project, name string, rank *int) (*models.ProjectAward, error) {
+	award := &models.Award{
+		Name:    name,
+		Event:   project.Event,
+		EventID: project.EventID,
+		Rank:    rank,
+	}
+	if err := awardRepo.FindOrCreateAward(award); err != nil {
+		return nil, err
+	}
+
+	grant, err := awardRepo.GetProjectAward(project.ID, award.ID)
+	if err == nil {
+		return grant, nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	grant = &models.ProjectAward{
+		ProjectID: project.ID,
+		AwardID:   award.ID,
+		GrantedAt: time.Now(),
+	}
+	if err := awardRepo.GrantAward(grant); err != nil {
+		return nil, err
+	}
+	grant.Award = award
+	return grant, nil
+}
+
+// sameEventID reports whether two optional event IDs refer to the same event
+func sameEventID(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// awardNames returns the names of the awards granted to a project
+func awardNames(project *models.Project) []string {
+	names := make([]string, 0, len(project.Awards))
+	for _, grant := range project.Awards {
+		if grant.Award != nil {
+			names = append(names, grant.Award.Name)
+		}
+	}
+	return names
+}
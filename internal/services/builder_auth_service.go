@@ -0,0 +1,224 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"monad-devhub-be/internal/middleware"
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/siwe"
+	"monad-devhub-be/internal/utils"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// siweNonceTTL is how long a nonce may take to come back in a signed message
+	siweNonceTTL = 10 * time.Minute
+	// siweClockSkew tolerates clients whose clocks run slightly ahead
+	siweClockSkew = 5 * time.Minute
+)
+
+type BuilderAuthService struct {
+	accountRepo *repository.BuilderAccountRepository
+	domains     []string
+	chainIDs    []int64
+	jwtSecret   string
+	sessionTTL  time.Duration
+}
+
+func NewBuilderAuthService(accountRepo *repository.BuilderAccountRepository, domains []string, chainIDs []int64, jwtSecret string, sessionTTL time.Duration) *BuilderAuthService {
+	return &BuilderAuthService{
+		accountRepo: accountRepo,
+		domains:     domains,
+		chainIDs:    chainIDs,
+		jwtSecret:   jwtSecret,
+		sessionTTL:  sessionTTL,
+	}
+}
+
+// NonceResponse is a nonce to embed in a Sign-In with Ethereum message
+type NonceResponse struct {
+	Nonce     string    `json:"nonce"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// SignInRequest carries a signed EIP-4361 message
+type SignInRequest struct {
+	Message   string `json:"message" binding:"required"`
+	Signature string `json:"signature" binding:"required"` // 0x-prefixed personal_sign signature
+}
+
+// SignInResponse carries the session token of a signed-in builder
+type SignInResponse struct {
+	Token     string                 `json:"token"`
+	ExpiresAt time.Time              `json:"expiresAt"`
+	Account   *models.BuilderAccount `json:"account"`
+}
+
+// BuilderMeResponse describes the signed-in builder
+type BuilderMeResponse struct {
+	Account  *models.BuilderAccount `json:"account"`
+	Projects []models.Project       `json:"projects"` // Projects the builder owns, including unpublished ones
+}
+
+// SetProjectOwnerRequest represents the payload for assigning a project to a builder account
+type SetProjectOwnerRequest struct {
+	Address *string `json:"address"` // Null clears the owner
+}
+
+// IssueNonce hands out a single-use nonce for a sign-in message
+func (s *BuilderAuthService) IssueNonce() (*NonceResponse, error) {
+	// Hex tokens satisfy the alphanumeric nonce grammar of EIP-4361
+	nonce, _, err := utils.GenerateSecretToken()
+	if err != nil {
+		return nil, err
+	}
+
+	record := &models.SiweNonce{Nonce: nonce, ExpiresAt: time.Now().Add(siweNonceTTL)}
+	if err := s.accountRepo.CreateNonce(record); err != nil {
+		return nil, err
+	}
+	return &NonceResponse{Nonce: record.Nonce, ExpiresAt: record.ExpiresAt}, nil
+}
+
+// SignIn verifies a signed EIP-4361 message and starts a builder session for the signing address.
+// The account is created on first sign-in.
+func (s *BuilderAuthService) SignIn(req *SignInRequest) (*SignInResponse, error) {
+	msg, err := siwe.Parse(req.Message)
+	if err != nil {
+		return nil, errors.New("INVALID_SIWE_MESSAGE: " + err.Error())
+	}
+
+	if !s.acceptsDomain(msg.Domain) {
+		return nil, errors.New("SIWE_DOMAIN_MISMATCH: Message was not issued for this site")
+	}
+	if !s.acceptsChain(msg.ChainID) {
+		return nil, errors.New("SIWE_CHAIN_MISMATCH: Chain ID is not accepted")
+	}
+
+	now := time.Now()
+	if msg.IssuedAt.After(now.Add(siweClockSkew)) {
+		return nil, errors.New("SIWE_NOT_YET_VALID: Message is issued in the future")
+	}
+	if msg.NotBefore != nil && msg.NotBefore.After(now.Add(siweClockSkew)) {
+		return nil, errors.New("SIWE_NOT_YET_VALID: Message is not valid yet")
+	}
+	if msg.ExpirationTime != nil && !msg.ExpirationTime.After(now) {
+		return nil, errors.New("SIWE_EXPIRED: Message has expired")
+	}
+
+	signer, err := siwe.RecoverAddress(req.Message, req.Signature)
+	if err != nil || signer != msg.Address {
+		return nil, errors.New("INVALID_SIGNATURE: Signature does not match the message's address")
+	}
+
+	// The nonce is used up only once the signature checks out, so bad requests cannot burn it
+	consumed, err := s.accountRepo.ConsumeNonce(msg.Nonce, now)
+	if err != nil {
+		return nil, err
+	}
+	if !consumed {
+		return nil, errors.New("INVALID_NONCE: Nonce is unknown, expired or already used")
+	}
+
+	address := utils.NormalizeAddress(msg.Address)
+	account, err := s.accountRepo.RecordLogin(address, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sessions never outlive the message they were started from
+	expiresAt := now.Add(s.sessionTTL)
+	if msg.ExpirationTime != nil && msg.ExpirationTime.Before(expiresAt) {
+		expiresAt = *msg.ExpirationTime
+	}
+
+	token, err := s.generateToken(account, now, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignInResponse{
+		Token:     token,
+		ExpiresAt: expiresAt,
+		Account:   account,
+	}, nil
+}
+
+// GetMe returns the signed-in builder's account and owned projects
+func (s *BuilderAuthService) GetMe(address string) (*BuilderMeResponse, error) {
+	account, err := s.accountRepo.GetAccountByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.accountRepo.GetOwnedProjects(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BuilderMeResponse{
+		Account:  account,
+		Projects: projects,
+	}, nil
+}
+
+// SetProjectOwner assigns a project to the builder account of an address, or clears its owner.
+// The address does not need to have signed in yet.
+func (s *BuilderAuthService) SetProjectOwner(projectID uint, req *SetProjectOwnerRequest) error {
+	var owner *string
+	if req.Address != nil && strings.TrimSpace(*req.Address) != "" {
+		address := utils.NormalizeAddress(*req.Address)
+		if address == "" {
+			return errors.New("INVALID_ADDRESS: Address must be a 0x-prefixed 20-byte hex address")
+		}
+		owner = &address
+	}
+
+	return s.accountRepo.SetProjectOwner(projectID, owner)
+}
+
+// acceptsDomain reports whether a message's domain is one of the configured frontends
+func (s *BuilderAuthService) acceptsDomain(domain string) bool {
+	for _, allowed := range s.domains {
+		if strings.EqualFold(domain, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsChain reports whether a chain ID is accepted; any chain is when none are configured
+func (s *BuilderAuthService) acceptsChain(chainID int64) bool {
+	if len(s.chainIDs) == 0 {
+		return true
+	}
+	for _, allowed := range s.chainIDs {
+		if chainID == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// generateToken signs a session token carrying the builder role and lowercase address
+func (s *BuilderAuthService) generateToken(account *models.BuilderAccount, issuedAt, expiresAt time.Time) (string, error) {
+	claims := middleware.Claims{
+		Role:     "builder",
+		Username: account.Address,
+		UserID:   account.ID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   account.Address,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			Issuer:    "monad-devhub-api",
+			Audience:  jwt.ClaimStrings{middleware.BuilderAudience},
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(s.jwtSecret))
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"errors"
+	"math"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+type BuilderService struct {
+	builderRepo *repository.BuilderRepository
+}
+
+func NewBuilderService(builderRepo *repository.BuilderRepository) *BuilderService {
+	return &BuilderService{
+		builderRepo: builderRepo,
+	}
+}
+
+// GetBuildersRequest represents the request for the builder directory
+type GetBuildersRequest struct {
+	Page   int    `form:"page" binding:"min=0"`
+	Limit  int    `form:"limit" binding:"min=0,max=100"`
+	Search string `form:"search"`
+}
+
+// GetBuildersResponse represents a page of the builder directory
+type GetBuildersResponse struct {
+	Builders   []repository.BuilderListItem `json:"builders"`
+	Pagination PaginationInfo               `json:"pagination"`
+}
+
+// BuilderAward is an award won by one of a builder's projects
+type BuilderAward struct {
+	ProjectID   uint         `json:"projectId"`
+	ProjectName string       `json:"projectName"`
+	Award       models.Award `json:"award"`
+}
+
+// BuilderProfile aggregates everything a builder worked on
+type BuilderProfile struct {
+	Builder  models.Builder   `json:"builder"`
+	Projects []models.Project `json:"projects"`
+	Awards   []BuilderAward   `json:"awards"`
+	Events   []string         `json:"events"`
+}
+
+// MergeBuildersRequest merges duplicate builders into one
+type MergeBuildersRequest struct {
+	TargetID  uint   `json:"targetId" binding:"required"`
+	SourceIDs []uint `json:"sourceIds" binding:"required,min=1,dive,required"`
+}
+
+// GetBuilders lists builders with published projects, optionally filtered by handle or name
+func (s *BuilderService) GetBuilders(req *GetBuildersRequest) (*GetBuildersResponse, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	offset := (req.Page - 1) * req.Limit
+
+	builders, err := s.builderRepo.GetBuilders(offset, req.Limit, req.Search)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.builderRepo.GetBuildersCount(req.Search)
+	if err != nil {
+		return nil, err
+	}
+
+	if builders == nil {
+		builders = []repository.BuilderListItem{}
+	}
+	return &GetBuildersResponse{
+		Builders: builders,
+		Pagination: PaginationInfo{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: int(math.Ceil(float64(total) / float64(req.Limit))),
+		},
+	}, nil
+}
+
+// GetBuilderProfile retrieves a builder by twitter handle (or profile URL) with their published projects
+func (s *BuilderService) GetBuilderProfile(handle string) (*BuilderProfile, error) {
+	normalized := utils.NormalizeTwitterHandle(handle)
+	if normalized == "" {
+		return nil, gorm.ErrRecordNotFound
+	}
+	builder, err := s.builderRepo.GetBuilderByHandle(normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.builderRepo.GetBuilderProjects(builder.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &BuilderProfile{
+		Builder:  *builder,
+		Projects: projects,
+		Awards:   []BuilderAward{},
+		Events:   []string{},
+	}
+	for _, project := range projects {
+		profile.Events = append(profile.Events, project.Event)
+		for _, grant := range project.Awards {
+			if grant.Award == nil {
+				continue
+			}
+			profile.Awards = append(profile.Awards, BuilderAward{
+				ProjectID:   project.ID,
+				ProjectName: project.Name,
+				Award:       *grant.Award,
+			})
+		}
+	}
+	profile.Events = utils.RemoveDuplicates(profile.Events)
+	if profile.Events == nil {
+		profile.Events = []string{}
+	}
+	return profile, nil
+}
+
+// MergeBuilders merges duplicate builders into the target builder and returns it
+func (s *BuilderService) MergeBuilders(req *MergeBuildersRequest) (*models.Builder, error) {
+	target, err := s.builderRepo.GetBuilderByID(req.TargetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []models.Builder
+	seen := make(map[uint]bool)
+	for _, id := range req.SourceIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if id == target.ID {
+			return nil, errors.New("INVALID_MERGE: A builder cannot be merged into itself")
+		}
+		source, err := s.builderRepo.GetBuilderByID(id)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, *source)
+	}
+
+	if err := s.builderRepo.MergeBuilders(target, sources); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
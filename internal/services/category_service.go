@@ -0,0 +1,255 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+type CategoryService struct {
+	categoryRepo *repository.CategoryRepository
+}
+
+func NewCategoryService(categoryRepo *repository.CategoryRepository) *CategoryService {
+	return &CategoryService{
+		categoryRepo: categoryRepo,
+	}
+}
+
+// CategoryRequest represents the payload for creating or updating a category
+type CategoryRequest struct {
+	Name         string   `json:"name" binding:"required"`
+	Slug         string   `json:"slug"` // Derived from the name when empty
+	ParentID     *uint    `json:"parentId"`
+	Icon         string   `json:"icon"`
+	DisplayOrder int      `json:"displayOrder"`
+	Aliases      []string `json:"aliases"`
+}
+
+// CategoryNode is a category together with its subcategories
+type CategoryNode struct {
+	models.Category
+	Children []CategoryNode `json:"children"`
+}
+
+// GetCategoryTree returns the category hierarchy in display order
+func (s *CategoryService) GetCategoryTree() ([]CategoryNode, error) {
+	categories, err := s.categoryRepo.GetCategories()
+	if err != nil {
+		return nil, err
+	}
+	return buildCategoryTree(categories, nil), nil
+}
+
+// CreateCategory creates a new category
+func (s *CategoryService) CreateCategory(req *CategoryRequest) (*models.Category, error) {
+	categories, err := s.categoryRepo.GetCategories()
+	if err != nil {
+		return nil, err
+	}
+
+	category := &models.Category{}
+	if err := applyCategoryRequest(category, req, categories); err != nil {
+		return nil, err
+	}
+
+	if err := s.categoryRepo.CreateCategory(category); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("DUPLICATE_CATEGORY: A category with this name or slug already exists")
+		}
+		return nil, err
+	}
+	return category, nil
+}
+
+// UpdateCategory replaces a category's details. Renaming migrates the categories stored on
+// projects and submissions, and the old name is kept as an alias so existing links keep working.
+func (s *CategoryService) UpdateCategory(id uint, req *CategoryRequest) (*models.Category, error) {
+	category, err := s.categoryRepo.GetCategoryByID(id)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := s.categoryRepo.GetCategories()
+	if err != nil {
+		return nil, err
+	}
+
+	previousName := category.Name
+	if err := applyCategoryRequest(category, req, categories); err != nil {
+		return nil, err
+	}
+	if category.Name != previousName && !containsFold(category.Aliases, previousName) {
+		category.Aliases = append(category.Aliases, previousName)
+	}
+
+	if err := s.categoryRepo.UpdateCategory(category, previousName); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("DUPLICATE_CATEGORY: A category with this name or slug already exists")
+		}
+		return nil, err
+	}
+	return category, nil
+}
+
+// DeleteCategory deletes a category that has no subcategories and is not in use
+func (s *CategoryService) DeleteCategory(id uint) error {
+	category, err := s.categoryRepo.GetCategoryByID(id)
+	if err != nil {
+		return err
+	}
+	categories, err := s.categoryRepo.GetCategories()
+	if err != nil {
+		return err
+	}
+	for _, other := range categories {
+		if other.ParentID != nil && *other.ParentID == category.ID {
+			return errors.New("CATEGORY_IN_USE: Category has subcategories")
+		}
+	}
+
+	usage, err := s.categoryRepo.CountCategoryUsage(category.Name)
+	if err != nil {
+		return err
+	}
+	if usage > 0 {
+		return errors.New("CATEGORY_IN_USE: Category is used by projects or submissions")
+	}
+
+	return s.categoryRepo.DeleteCategory(category.ID)
+}
+
+// applyCategoryRequest validates the request against the existing taxonomy and copies it onto the category
+func applyCategoryRequest(category *models.Category, req *CategoryRequest, categories []models.Category) error {
+	slug := req.Slug
+	if slug == "" {
+		slug = utils.Slugify(req.Name)
+	}
+	if !utils.ValidateSlug(slug) {
+		return errors.New("INVALID_CATEGORY: Slug must contain only lowercase letters, digits and single dashes")
+	}
+
+	byID := make(map[uint]models.Category)
+	for _, other := range categories {
+		byID[other.ID] = other
+	}
+
+	// The parent must exist and must not be the category itself or one of its descendants.
+	// Stored parents are walked with a visited set, so a cycle already in the table cannot loop forever
+	visited := make(map[uint]bool)
+	for parentID := req.ParentID; parentID != nil; parentID = byID[*parentID].ParentID {
+		if _, ok := byID[*parentID]; !ok {
+			return errors.New("INVALID_CATEGORY: Parent category not found")
+		}
+		if category.ID != 0 && *parentID == category.ID {
+			return errors.New("INVALID_CATEGORY: A category cannot be nested under itself")
+		}
+		if visited[*parentID] {
+			return errors.New("INVALID_CATEGORY: Parent categories form a cycle")
+		}
+		visited[*parentID] = true
+	}
+
+	// Names and aliases must resolve to exactly one category
+	aliases := utils.RemoveDuplicates(utils.RemoveEmpty(req.Aliases))
+	for _, other := range categories {
+		if other.ID == category.ID {
+			continue
+		}
+		for _, label := range append([]string{req.Name}, aliases...) {
+			if strings.EqualFold(other.Name, label) || containsFold(other.Aliases, label) {
+				return errors.New("DUPLICATE_CATEGORY: " + label + " is already used by category " + other.Name)
+			}
+		}
+	}
+
+	category.Name = req.Name
+	category.Slug = slug
+	category.ParentID = req.ParentID
+	category.Icon = req.Icon
+	category.DisplayOrder = req.DisplayOrder
+	category.Aliases = aliases
+	return nil
+}
+
+// buildCategoryTree nests categories under their parents
+func buildCategoryTree(categories []models.Category, parentID *uint) []CategoryNode {
+	nodes := []CategoryNode{}
+	for _, category := range categories {
+		if (parentID == nil && category.ParentID == nil) || (parentID != nil && category.ParentID != nil && *category.ParentID == *parentID) {
+			nodes = append(nodes, CategoryNode{
+				Category: category,
+				Children: buildCategoryTree(categories, &category.ID),
+			})
+		}
+	}
+	return nodes
+}
+
+// resolveCategoryNames maps names and aliases (case-insensitively) to canonical category names.
+// It returns false if any name is unknown.
+func resolveCategoryNames(categories []models.Category, names []string) ([]string, bool) {
+	resolved := make([]string, 0, len(names))
+	for _, name := range names {
+		category := findCategory(categories, name)
+		if category == nil {
+			return nil, false
+		}
+		resolved = append(resolved, category.Name)
+	}
+	return utils.RemoveDuplicates(resolved), true
+}
+
+// expandCategoryNames adds every descendant of the given categories, so filtering by a parent
+// category also matches projects tagged with its children. Unknown names are kept as given.
+func expandCategoryNames(categories []models.Category, names []string) []string {
+	var expanded []string
+	for _, name := range names {
+		category := findCategory(categories, name)
+		if category == nil {
+			expanded = append(expanded, name)
+			continue
+		}
+
+		pending := []uint{category.ID}
+		visited := map[uint]bool{category.ID: true}
+		expanded = append(expanded, category.Name)
+		for len(pending) > 0 {
+			parentID := pending[0]
+			pending = pending[1:]
+			for _, child := range categories {
+				if child.ParentID != nil && *child.ParentID == parentID && !visited[child.ID] {
+					visited[child.ID] = true
+					expanded = append(expanded, child.Name)
+					pending = append(pending, child.ID)
+				}
+			}
+		}
+	}
+	return utils.RemoveDuplicates(expanded)
+}
+
+// findCategory finds a category by name, alias or slug
+func findCategory(categories []models.Category, label string) *models.Category {
+	label = strings.TrimSpace(label)
+	for i, category := range categories {
+		if strings.EqualFold(category.Name, label) || category.Slug == label || containsFold(category.Aliases, label) {
+			return &categories[i]
+		}
+	}
+	return nil
+}
+
+// containsFold reports whether the slice contains the value, ignoring case
+func containsFold(slice []string, value string) bool {
+	for _, item := range slice {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,563 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/utils"
+)
+
+// changeableProjectFields lists the project fields owners may propose changes to, by JSON name
+var changeableProjectFields = []string{"description", "logo", "howToPlay", "playUrl", "github", "website", "team"}
+
+type ChangeRequestService struct {
+	changeRepo        *repository.ChangeRequestRepository
+	projectRepo       *repository.ProjectRepository
+	autoApproveFields []string
+}
+
+// NewChangeRequestService creates the service; autoApproveFields names the low-risk fields that skip review,
+// and names of fields owners cannot change are ignored
+func NewChangeRequestService(changeRepo *repository.ChangeRequestRepository, projectRepo *repository.ProjectRepository, autoApproveFields []string) *ChangeRequestService {
+	var fields []string
+	for _, field := range autoApproveFields {
+		if utils.Contains(changeableProjectFields, strings.TrimSpace(field)) {
+			fields = append(fields, strings.TrimSpace(field))
+		}
+	}
+
+	return &ChangeRequestService{
+		changeRepo:        changeRepo,
+		projectRepo:       projectRepo,
+		autoApproveFields: fields,
+	}
+}
+
+// ProposeChangesRequest represents the payload for proposing edits to a project; omitted fields stay as they are
+type ProposeChangesRequest struct {
+	Description *string                   `json:"description"`
+	Logo        *string                   `json:"logo"`
+	HowToPlay   *string                   `json:"howToPlay"`
+	PlayURL     *string                   `json:"playUrl"`
+	GithubURL   *string                   `json:"github"`  // Empty removes the link
+	WebsiteURL  *string                   `json:"website"` // Empty removes the link
+	Team        *[]models.TeamMemberInput `json:"team"`
+	Note        string                    `json:"note" binding:"max=1000"`
+}
+
+// ProposeChangesResponse reports what happened to a proposal: low-risk fields may have been applied
+// right away, and the rest queued for review
+type ProposeChangesResponse struct {
+	Applied *models.ProjectChangeRequest `json:"applied,omitempty"`
+	Pending *models.ProjectChangeRequest `json:"pending,omitempty"`
+}
+
+// GetChangeRequestsRequest represents the review queue filters
+type GetChangeRequestsRequest struct {
+	Status    string `form:"status"`
+	ProjectID *uint  `form:"projectId"`
+	Page      int    `form:"page" binding:"omitempty,min=1"`
+	Limit     int    `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ChangeRequestsResponse is a page of change requests
+type ChangeRequestsResponse struct {
+	ChangeRequests []models.ProjectChangeRequest `json:"changeRequests"`
+	Pagination     PaginationInfo                `json:"pagination"`
+}
+
+// ReviewChangeRequestRequest represents the payload for approving or rejecting a change request
+type ReviewChangeRequestRequest struct {
+	Note string `json:"note" binding:"max=1000"` // Required when rejecting
+}
+
+// GetChangeLogRequest represents the pagination of a project's change log
+type GetChangeLogRequest struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// ChangeLogResponse is a page of a project's change log
+type ChangeLogResponse struct {
+	Changes    []models.ProjectChangeLog `json:"changes"`
+	Pagination PaginationInfo            `json:"pagination"`
+}
+
+// ProposeChanges records an owner's proposed edits to their project. Fields configured as low-risk are
+// applied immediately; the others wait in the review queue, one pending request per project at a time.
+func (s *ChangeRequestService) ProposeChanges(projectID uint, ownerAddress string, req *ProposeChangesRequest) (*ProposeChangesResponse, error) {
+	project, err := s.ownedProject(projectID, ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := diffProject(project, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return nil, errors.New("INVALID_CHANGE_REQUEST: The proposal does not change anything")
+	}
+
+	// Split off the fields that may skip review
+	auto, review := models.ChangeSet{}, models.ChangeSet{}
+	for field, change := range changes {
+		if utils.Contains(s.autoApproveFields, field) {
+			auto[field] = change
+		} else {
+			review[field] = change
+		}
+	}
+
+	if len(review) > 0 {
+		pending, err := s.changeRepo.HasPendingChangeRequest(projectID)
+		if err != nil {
+			return nil, err
+		}
+		if pending {
+			return nil, errors.New("CHANGE_PENDING: A change request for this project is already awaiting review")
+		}
+	}
+
+	response := &ProposeChangesResponse{}
+	now := time.Now()
+	if len(auto) > 0 {
+		request := &models.ProjectChangeRequest{
+			ProjectID:    projectID,
+			RequestedBy:  ownerAddress,
+			Changes:      auto,
+			Note:         req.Note,
+			Status:       "approved",
+			AutoApproved: true,
+			ReviewedAt:   &now,
+		}
+		edit, err := buildProjectEdit(project, auto, "builder:"+ownerAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.changeRepo.CreateAppliedChangeRequest(request, edit); err != nil {
+			return nil, err
+		}
+		response.Applied = request
+	}
+
+	if len(review) > 0 {
+		request := &models.ProjectChangeRequest{
+			ProjectID:   projectID,
+			RequestedBy: ownerAddress,
+			Changes:     review,
+			Note:        req.Note,
+			Status:      "pending",
+		}
+		if err := s.changeRepo.CreateChangeRequest(request); err != nil {
+			return nil, err
+		}
+		response.Pending = request
+	}
+
+	return response, nil
+}
+
+// GetOwnerChangeRequests lists an owner's change requests for their project
+func (s *ChangeRequestService) GetOwnerChangeRequests(projectID uint, ownerAddress string) ([]models.ProjectChangeRequest, error) {
+	if _, err := s.ownedProject(projectID, ownerAddress); err != nil {
+		return nil, err
+	}
+
+	filter := repository.ChangeRequestFilter{ProjectID: &projectID, RequestedBy: ownerAddress}
+	requests, _, err := s.changeRepo.GetChangeRequests(filter, 0, 100)
+	return requests, err
+}
+
+// WithdrawChangeRequest lets an owner take back their pending change request
+func (s *ChangeRequestService) WithdrawChangeRequest(projectID, requestID uint, ownerAddress string) (*models.ProjectChangeRequest, error) {
+	request, err := s.changeRepo.GetChangeRequestByID(requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.ProjectID != projectID || request.RequestedBy != ownerAddress {
+		return nil, errors.New("FORBIDDEN: You can only withdraw your own change requests")
+	}
+
+	withdrawn, err := s.changeRepo.ReviewChangeRequest(requestID, "withdrawn", nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !withdrawn {
+		return nil, errors.New("INVALID_REVIEW: Only pending change requests can be withdrawn")
+	}
+	return s.changeRepo.GetChangeRequestByID(requestID)
+}
+
+// GetChangeRequests lists change requests for reviewers, newest first
+func (s *ChangeRequestService) GetChangeRequests(req *GetChangeRequestsRequest) (*ChangeRequestsResponse, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Status != "" && !utils.Contains([]string{"pending", "approved", "rejected", "withdrawn"}, req.Status) {
+		return nil, errors.New("INVALID_STATUS: Status must be pending, approved, rejected or withdrawn")
+	}
+
+	filter := repository.ChangeRequestFilter{ProjectID: req.ProjectID, Status: req.Status}
+	requests, total, err := s.changeRepo.GetChangeRequests(filter, (req.Page-1)*req.Limit, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChangeRequestsResponse{
+		ChangeRequests: requests,
+		Pagination: PaginationInfo{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: int(math.Ceil(float64(total) / float64(req.Limit))),
+		},
+	}, nil
+}
+
+// ApproveChangeRequest applies a pending change request to its project and records each changed field
+func (s *ChangeRequestService) ApproveChangeRequest(id, reviewerID uint, req *ReviewChangeRequestRequest) (*models.ProjectChangeRequest, error) {
+	request, err := s.changeRepo.GetChangeRequestByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != "pending" {
+		return nil, errors.New("INVALID_REVIEW: Only pending change requests can be approved")
+	}
+
+	project, err := s.projectRepo.GetProjectByID(request.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Approving must not overwrite edits made to the same fields since the owner proposed the change
+	stale, err := staleFields(project, request.Changes)
+	if err != nil {
+		return nil, err
+	}
+	if len(stale) > 0 {
+		return nil, errors.New("CHANGE_REQUEST_STALE: The project's " + strings.Join(stale, ", ") + " changed since this request was proposed")
+	}
+
+	edit, err := buildProjectEdit(project, request.Changes, "admin:"+strconv.FormatUint(uint64(reviewerID), 10))
+	if err != nil {
+		return nil, err
+	}
+
+	approved, current, err := s.changeRepo.ApproveChangeRequest(id, reviewerPtr(reviewerID), optionalNote(req.Note), edit, project.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if !current {
+		return nil, errors.New("CHANGE_REQUEST_STALE: The project changed while the request was being approved; please retry")
+	}
+	if !approved {
+		return nil, errors.New("INVALID_REVIEW: Only pending change requests can be approved")
+	}
+	return s.changeRepo.GetChangeRequestByID(id)
+}
+
+// RejectChangeRequest closes a pending change request without applying it
+func (s *ChangeRequestService) RejectChangeRequest(id, reviewerID uint, req *ReviewChangeRequestRequest) (*models.ProjectChangeRequest, error) {
+	reason := strings.TrimSpace(req.Note)
+	if reason == "" {
+		return nil, errors.New("INVALID_CHANGE_REQUEST: A reason is required to reject a change request")
+	}
+
+	rejected, err := s.changeRepo.ReviewChangeRequest(id, "rejected", reviewerPtr(reviewerID), &reason, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !rejected {
+		return nil, errors.New("INVALID_REVIEW: Only pending change requests can be rejected")
+	}
+	return s.changeRepo.GetChangeRequestByID(id)
+}
+
+// GetChangeLog lists the recorded changes of a project, newest first
+func (s *ChangeRequestService) GetChangeLog(projectID uint, req *GetChangeLogRequest) (*ChangeLogResponse, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 50
+	}
+
+	if _, err := s.projectRepo.GetProjectByID(projectID); err != nil {
+		return nil, err
+	}
+
+	logs, total, err := s.changeRepo.GetChangeLog(projectID, (req.Page-1)*req.Limit, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChangeLogResponse{
+		Changes: logs,
+		Pagination: PaginationInfo{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: int(math.Ceil(float64(total) / float64(req.Limit))),
+		},
+	}, nil
+}
+
+// ownedProject loads a project and checks that the address owns it
+func (s *ChangeRequestService) ownedProject(projectID uint, ownerAddress string) (*models.Project, error) {
+	project, err := s.projectRepo.GetProjectByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if ownerAddress == "" || project.OwnerAddress == nil || *project.OwnerAddress != ownerAddress {
+		return nil, errors.New("FORBIDDEN: Only the project's owner can change it")
+	}
+	return project, nil
+}
+
+// diffProject validates a proposal and returns the fields whose values it changes
+func diffProject(project *models.Project, req *ProposeChangesRequest) (models.ChangeSet, error) {
+	changes := models.ChangeSet{}
+
+	required := []struct {
+		field   string
+		current string
+		value   *string
+		isURL   bool
+	}{
+		{"description", project.Description, req.Description, false},
+		{"logo", project.Logo, req.Logo, true},
+		{"howToPlay", project.HowToPlay, req.HowToPlay, false},
+		{"playUrl", project.PlayURL, req.PlayURL, true},
+	}
+	for _, f := range required {
+		if f.value == nil {
+			continue
+		}
+		value := strings.TrimSpace(*f.value)
+		if value == "" {
+			return nil, errors.New("INVALID_CHANGE_REQUEST: " + f.field + " must not be empty")
+		}
+		if f.isURL && !validHTTPURL(value) {
+			return nil, errors.New("INVALID_CHANGE_REQUEST: " + f.field + " must be an http(s) URL")
+		}
+		if value != f.current {
+			changes[f.field] = models.FieldChange{From: f.current, To: value}
+		}
+	}
+
+	optional := []struct {
+		field   string
+		current *string
+		value   *string
+	}{
+		{"github", project.GithubURL, req.GithubURL},
+		{"website", project.WebsiteURL, req.WebsiteURL},
+	}
+	for _, f := range optional {
+		if f.value == nil {
+			continue
+		}
+		value := strings.TrimSpace(*f.value)
+		if value != "" && !validHTTPURL(value) {
+			return nil, errors.New("INVALID_CHANGE_REQUEST: " + f.field + " must be an http(s) URL")
+		}
+		current := ""
+		if f.current != nil {
+			current = *f.current
+		}
+		if value != current {
+			changes[f.field] = models.FieldChange{From: current, To: value}
+		}
+	}
+
+	if req.Team != nil {
+		team := make([]models.TeamMemberInput, 0, len(*req.Team))
+		for _, member := range *req.Team {
+			member.Name = strings.TrimSpace(member.Name)
+			member.Twitter = strings.TrimSpace(member.Twitter)
+			if member.Name == "" || member.Twitter == "" {
+				return nil, errors.New("INVALID_TEAM_MEMBERS: All team members must have name and twitter")
+			}
+			team = append(team, member)
+		}
+		if len(team) == 0 {
+			return nil, errors.New("INVALID_TEAM_MEMBERS: A project needs at least one team member")
+		}
+
+		current := teamInputs(project.TeamMembers)
+		if !reflect.DeepEqual(current, team) {
+			changes["team"] = models.FieldChange{From: current, To: team}
+		}
+	}
+
+	return changes, nil
+}
+
+// staleFields lists, sorted, the fields whose current value no longer matches the value a change was proposed against
+func staleFields(project *models.Project, changes models.ChangeSet) ([]string, error) {
+	var stale []string
+	for field, change := range changes {
+		var current interface{}
+		switch field {
+		case "team":
+			current = teamInputs(project.TeamMembers)
+		case "description":
+			current = project.Description
+		case "logo":
+			current = project.Logo
+		case "howToPlay":
+			current = project.HowToPlay
+		case "playUrl":
+			current = project.PlayURL
+		case "github", "website":
+			value := project.GithubURL
+			if field == "website" {
+				value = project.WebsiteURL
+			}
+			current = ""
+			if value != nil {
+				current = *value
+			}
+		default:
+			return nil, errors.New("INVALID_CHANGE_REQUEST: " + field + " cannot be changed")
+		}
+
+		// Stored values come back from jsonb as generic values, so the current one is compared in the same form
+		var normalized interface{}
+		if err := remarshal(current, &normalized); err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(normalized, change.From) {
+			stale = append(stale, field)
+		}
+	}
+	sort.Strings(stale)
+	return stale, nil
+}
+
+// buildProjectEdit turns approved changes into column updates, a team replacement and change log entries.
+// Log entries record the project's values at the time of applying, which may differ from when the change was proposed.
+func buildProjectEdit(project *models.Project, changes models.ChangeSet, changedBy string) (*repository.ProjectEdit, error) {
+	edit := &repository.ProjectEdit{Columns: map[string]interface{}{}}
+
+	for field, change := range changes {
+		var oldValue, newValue interface{}
+		switch field {
+		case "team":
+			// Stored changes come back from jsonb as generic values
+			var team []models.TeamMemberInput
+			if err := remarshal(change.To, &team); err != nil {
+				return nil, err
+			}
+			edit.Team = team
+			oldValue, newValue = teamInputs(project.TeamMembers), team
+
+		case "description", "logo", "howToPlay", "playUrl":
+			value, _ := change.To.(string)
+			column := map[string]string{"description": "description", "logo": "logo", "howToPlay": "how_to_play", "playUrl": "play_url"}[field]
+			current := map[string]string{"description": project.Description, "logo": project.Logo, "howToPlay": project.HowToPlay, "playUrl": project.PlayURL}[field]
+			edit.Columns[column] = value
+			if field == "logo" {
+				// The thumbnail belonged to the previous, uploaded logo
+				edit.Columns["logo_thumbnail"] = ""
+			}
+			oldValue, newValue = current, value
+
+		case "github", "website":
+			value, _ := change.To.(string)
+			column, current := "github_url", project.GithubURL
+			if field == "website" {
+				column, current = "website_url", project.WebsiteURL
+			}
+			var stored *string
+			if value != "" {
+				stored = &value
+			}
+			edit.Columns[column] = stored
+			oldValue, newValue = current, stored
+
+		default:
+			return nil, errors.New("INVALID_CHANGE_REQUEST: " + field + " cannot be changed")
+		}
+
+		log, err := newChangeLog(field, oldValue, newValue, changedBy)
+		if err != nil {
+			return nil, err
+		}
+		edit.Logs = append(edit.Logs, *log)
+	}
+
+	return edit, nil
+}
+
+// newChangeLog records one changed field of a project
+func newChangeLog(field string, oldValue, newValue interface{}, changedBy string) (*models.ProjectChangeLog, error) {
+	oldJSON, err := json.Marshal(oldValue)
+	if err != nil {
+		return nil, err
+	}
+	newJSON, err := json.Marshal(newValue)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ProjectChangeLog{
+		Field:     field,
+		OldValue:  models.JSON(oldJSON),
+		NewValue:  models.JSON(newJSON),
+		ChangedBy: changedBy,
+	}, nil
+}
+
+// teamInputs lists a team the way change requests and change logs record it
+func teamInputs(members []models.TeamMember) []models.TeamMemberInput {
+	team := make([]models.TeamMemberInput, 0, len(members))
+	for _, member := range members {
+		team = append(team, models.TeamMemberInput{Name: member.Name, Twitter: member.Twitter})
+	}
+	return team
+}
+
+// remarshal converts a generic JSON value into a typed one
+func remarshal(value interface{}, target interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// validHTTPURL reports whether value is an absolute http or https URL
+func validHTTPURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// reviewerPtr returns the reviewer's AdminUser ID, or nil for the environment fallback admin
+func reviewerPtr(reviewerID uint) *uint {
+	if reviewerID == 0 {
+		return nil
+	}
+	return &reviewerID
+}
+
+// optionalNote returns nil for a blank note
+func optionalNote(note string) *string {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return nil
+	}
+	return &note
+}
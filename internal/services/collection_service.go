@@ -0,0 +1,173 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+type CollectionService struct {
+	collectionRepo *repository.CollectionRepository
+}
+
+func NewCollectionService(collectionRepo *repository.CollectionRepository) *CollectionService {
+	return &CollectionService{
+		collectionRepo: collectionRepo,
+	}
+}
+
+// CollectionRequest represents the payload for creating or updating a collection
+type CollectionRequest struct {
+	Slug         string `json:"slug"` // Derived from the title when empty
+	Title        string `json:"title" binding:"required"`
+	Description  string `json:"description"`
+	Status       string `json:"status" binding:"omitempty,oneof=draft published"`
+	DisplayOrder int    `json:"displayOrder"`
+	ProjectIDs   []uint `json:"projectIds"` // Projects in display order; omitted keeps the current list on update
+}
+
+// GetCollections lists collections with their project counts; drafts and unpublished projects are only included for admins
+func (s *CollectionService) GetCollections(includeDrafts bool) ([]models.Collection, error) {
+	collections, err := s.collectionRepo.GetCollections(includeDrafts)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, len(collections))
+	for i, collection := range collections {
+		ids[i] = collection.ID
+	}
+	counts, err := s.collectionRepo.GetProjectCounts(ids, includeDrafts)
+	if err != nil {
+		return nil, err
+	}
+	for i := range collections {
+		collections[i].ProjectCount = counts[collections[i].ID]
+	}
+	return collections, nil
+}
+
+// GetCollection retrieves a published collection by slug with its published projects in order
+func (s *CollectionService) GetCollection(slug string) (*models.Collection, error) {
+	collection, err := s.collectionRepo.GetCollectionBySlug(slug)
+	if err != nil {
+		return nil, err
+	}
+	if collection.Status != "published" {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.withProjects(collection, false)
+}
+
+// GetCollectionForAdmin retrieves any collection by ID with all of its projects, including unpublished ones
+func (s *CollectionService) GetCollectionForAdmin(id uint) (*models.Collection, error) {
+	collection, err := s.collectionRepo.GetCollectionByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.withProjects(collection, true)
+}
+
+// CreateCollection creates a collection
+func (s *CollectionService) CreateCollection(req *CollectionRequest) (*models.Collection, error) {
+	collection := &models.Collection{}
+	if err := s.applyCollectionRequest(collection, req); err != nil {
+		return nil, err
+	}
+
+	projectIDs := req.ProjectIDs
+	if projectIDs == nil {
+		projectIDs = []uint{}
+	}
+	if err := s.collectionRepo.CreateCollection(collection, projectIDs); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("DUPLICATE_COLLECTION: A collection with this slug already exists")
+		}
+		return nil, err
+	}
+	return s.withProjects(collection, true)
+}
+
+// UpdateCollection replaces a collection's details and, when projectIds is sent, its projects
+func (s *CollectionService) UpdateCollection(id uint, req *CollectionRequest) (*models.Collection, error) {
+	collection, err := s.collectionRepo.GetCollectionByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyCollectionRequest(collection, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.collectionRepo.UpdateCollection(collection, req.ProjectIDs); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("DUPLICATE_COLLECTION: A collection with this slug already exists")
+		}
+		return nil, err
+	}
+	return s.withProjects(collection, true)
+}
+
+// DeleteCollection deletes a collection; its projects are not affected
+func (s *CollectionService) DeleteCollection(id uint) error {
+	return s.collectionRepo.DeleteCollection(id)
+}
+
+// withProjects loads a collection's projects and count
+func (s *CollectionService) withProjects(collection *models.Collection, includeUnpublished bool) (*models.Collection, error) {
+	projects, err := s.collectionRepo.GetCollectionProjects(collection.ID, includeUnpublished)
+	if err != nil {
+		return nil, err
+	}
+	collection.Projects = projects
+	collection.ProjectCount = len(projects)
+	return collection, nil
+}
+
+// applyCollectionRequest validates the request and copies it onto the collection
+func (s *CollectionService) applyCollectionRequest(collection *models.Collection, req *CollectionRequest) error {
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return errors.New("INVALID_COLLECTION: Title must not be empty")
+	}
+	slug := req.Slug
+	if slug == "" {
+		slug = utils.Slugify(title)
+	}
+	if !utils.ValidateSlug(slug) {
+		return errors.New("INVALID_COLLECTION: Slug must contain only lowercase letters, digits and single dashes")
+	}
+
+	if len(req.ProjectIDs) > 0 {
+		seen := make(map[uint]bool)
+		for _, id := range req.ProjectIDs {
+			if seen[id] {
+				return errors.New("INVALID_COLLECTION: projectIds must not repeat a project")
+			}
+			seen[id] = true
+		}
+		count, err := s.collectionRepo.CountProjects(req.ProjectIDs)
+		if err != nil {
+			return err
+		}
+		if int(count) != len(req.ProjectIDs) {
+			return errors.New("INVALID_COLLECTION: projectIds contains unknown or deleted projects")
+		}
+	}
+
+	status := req.Status
+	if status == "" {
+		status = "draft"
+	}
+
+	collection.Slug = slug
+	collection.Title = title
+	collection.Description = req.Description
+	collection.Status = status
+	collection.DisplayOrder = req.DisplayOrder
+	return nil
+}
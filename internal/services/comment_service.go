@@ -0,0 +1,483 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/moderation"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+// maxCommentDepth is how many levels of replies a thread may have below its top-level comment
+const maxCommentDepth = 5
+
+type CommentService struct {
+	commentRepo  *repository.CommentRepository
+	projectRepo  *repository.ProjectRepository
+	filter       *moderation.Filter
+	perMinute    int
+	perHourPerIP int
+	ipSecret     string
+}
+
+func NewCommentService(commentRepo *repository.CommentRepository, projectRepo *repository.ProjectRepository, filter *moderation.Filter, perMinute, perHourPerIP int, ipSecret string) *CommentService {
+	return &CommentService{
+		commentRepo:  commentRepo,
+		projectRepo:  projectRepo,
+		filter:       filter,
+		perMinute:    perMinute,
+		perHourPerIP: perHourPerIP,
+		ipSecret:     ipSecret,
+	}
+}
+
+// CommentAuthor identifies who is posting: a signed-in builder's address or the comment token
+// issued with their first comment, and their IP address
+type CommentAuthor struct {
+	BuilderAddress string
+	Token          string
+	IP             string
+}
+
+// CreateCommentRequest represents the payload for commenting on a project
+type CreateCommentRequest struct {
+	AuthorName string `json:"authorName" binding:"required,max=50"`
+	Body       string `json:"body" binding:"required,max=2000"`
+	ParentID   *uint  `json:"parentId,omitempty"` // Comment being replied to
+}
+
+// CreateCommentResponse returns the new comment and, for first-time authors, their comment token
+type CreateCommentResponse struct {
+	Comment     *models.Comment `json:"comment"`
+	AuthorToken string          `json:"authorToken,omitempty"` // Send back as X-Comment-Token to post as the same author or delete own comments
+}
+
+// GetCommentsRequest represents the pagination of a project's comment threads
+type GetCommentsRequest struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
+// GetCommentsResponse is a page of top-level comments with all of their replies
+type GetCommentsResponse struct {
+	Comments   []models.Comment `json:"comments"`
+	Pagination PaginationInfo   `json:"pagination"`
+}
+
+// GetModerationCommentsRequest represents the filters of the moderation list
+type GetModerationCommentsRequest struct {
+	Page      int    `form:"page" binding:"omitempty,min=1"`
+	Limit     int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Status    string `form:"status" binding:"omitempty,oneof=visible hidden deleted"`
+	ProjectID *uint  `form:"projectId"`
+}
+
+// ModerationCommentsResponse is a page of comments for moderators
+type ModerationCommentsResponse struct {
+	Comments   []models.Comment `json:"comments"`
+	Pagination PaginationInfo   `json:"pagination"`
+}
+
+// HideCommentRequest represents the payload for hiding a comment
+type HideCommentRequest struct {
+	Reason string `json:"reason"`
+}
+
+// BanAuthorRequest represents the payload for banning the author of a comment
+type BanAuthorRequest struct {
+	Reason       string `json:"reason" binding:"required"`
+	Days         *int   `json:"days" binding:"omitempty,min=1"` // Nil for a permanent ban
+	IncludeIP    bool   `json:"includeIp"`                      // Also ban the IP address the comment was posted from
+	HideComments bool   `json:"hideComments"`                   // Hide every visible comment of the author
+}
+
+// BanAuthorResponse returns the ban and how many comments it hid
+type BanAuthorResponse struct {
+	Ban            *models.CommentBan `json:"ban"`
+	HiddenComments int64              `json:"hiddenComments"`
+}
+
+// GetComments retrieves a page of a published project's comment threads.
+// Hidden and deleted comments are left out, or shown without author and body when they still have visible replies.
+func (s *CommentService) GetComments(projectID uint, req *GetCommentsRequest) (*GetCommentsResponse, error) {
+	// Set defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+
+	if _, err := s.projectRepo.GetPublishedProjectByID(projectID); err != nil {
+		return nil, err
+	}
+
+	offset := (req.Page - 1) * req.Limit
+	threads, err := s.commentRepo.GetThreads(projectID, offset, req.Limit, false)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.commentRepo.GetThreadsCount(projectID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rootIDs := make([]uint, len(threads))
+	for i, thread := range threads {
+		rootIDs[i] = thread.ID
+	}
+	replies, err := s.commentRepo.GetReplies(rootIDs)
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[uint][]models.Comment)
+	for _, reply := range replies {
+		children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+	}
+
+	comments := []models.Comment{}
+	for _, thread := range threads {
+		if comment, ok := assembleThread(thread, children); ok {
+			comments = append(comments, comment)
+		}
+	}
+
+	return &GetCommentsResponse{
+		Comments: comments,
+		Pagination: PaginationInfo{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: int(math.Ceil(float64(total) / float64(req.Limit))),
+		},
+	}, nil
+}
+
+// assembleThread attaches replies to their parents for public display. Comments that are not
+// visible keep their place only when they have visible replies, without author and body.
+func assembleThread(comment models.Comment, children map[uint][]models.Comment) (models.Comment, bool) {
+	for _, child := range children[comment.ID] {
+		if reply, ok := assembleThread(child, children); ok {
+			comment.Replies = append(comment.Replies, reply)
+		}
+	}
+
+	comment.ModerationNote = nil
+	if comment.Status != "visible" {
+		if len(comment.Replies) == 0 {
+			return comment, false
+		}
+		comment.AuthorName = ""
+		comment.Body = ""
+	}
+	return comment, true
+}
+
+// CreateComment posts a comment or reply on a published project after screening it for bans, rate limits and blocked content
+func (s *CommentService) CreateComment(projectID uint, req *CreateCommentRequest, author CommentAuthor) (*CreateCommentResponse, error) {
+	authorName := strings.TrimSpace(req.AuthorName)
+	body := strings.TrimSpace(req.Body)
+	if authorName == "" || body == "" {
+		return nil, errors.New("INVALID_COMMENT: authorName and body must not be empty")
+	}
+	for _, text := range []string{authorName, body} {
+		if verdict := s.filter.Check(text); !verdict.Allowed {
+			return nil, errors.New("COMMENT_REJECTED: " + verdict.Reason)
+		}
+	}
+
+	if _, err := s.projectRepo.GetPublishedProjectByID(projectID); err != nil {
+		return nil, err
+	}
+
+	// Signed-in builders post as their account; first-time anonymous authors get a token that identifies them from now on
+	response := &CreateCommentResponse{}
+	var authorKey string
+	newAuthor := false
+	if author.BuilderAddress != "" {
+		authorKey = builderAuthorKey(author.BuilderAddress)
+	} else if author.Token != "" {
+		if !validCommentToken(author.Token) {
+			return nil, errors.New("INVALID_COMMENT_TOKEN: Comment token is malformed")
+		}
+		authorKey = utils.HashSecretToken(author.Token)
+	} else {
+		token, hash, err := utils.GenerateSecretToken()
+		if err != nil {
+			return nil, err
+		}
+		response.AuthorToken = token
+		authorKey = hash
+		newAuthor = true
+	}
+	ipHash := utils.KeyedHash(s.ipSecret, author.IP)
+
+	comment := &models.Comment{
+		ProjectID:  projectID,
+		AuthorName: authorName,
+		AuthorKey:  authorKey,
+		IPHash:     ipHash,
+		Body:       body,
+		Status:     "visible",
+	}
+	if req.ParentID != nil {
+		parent, err := s.commentRepo.GetProjectComment(projectID, *req.ParentID)
+		if err == gorm.ErrRecordNotFound || (err == nil && parent.Status != "visible") {
+			return nil, errors.New("INVALID_PARENT: The comment being replied to does not exist")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if parent.Depth >= maxCommentDepth {
+			return nil, errors.New("INVALID_PARENT: Replies cannot be nested more than " + strconv.Itoa(maxCommentDepth) + " levels deep")
+		}
+
+		rootID := parent.ID
+		if parent.RootID != nil {
+			rootID = *parent.RootID
+		}
+		comment.ParentID = &parent.ID
+		comment.RootID = &rootID
+		comment.Depth = parent.Depth + 1
+	}
+
+	// The checks and the insert run under a lock on the author and IP address, so concurrent
+	// requests cannot all pass the rate limits before any of them is counted
+	err := s.commentRepo.Transaction(func(tx *gorm.DB) error {
+		commentRepo := s.commentRepo.WithTx(tx)
+		if err := commentRepo.LockCommenters(authorKey, ipHash); err != nil {
+			return err
+		}
+		if err := s.checkAuthorAllowed(commentRepo, authorKey, ipHash, newAuthor); err != nil {
+			return err
+		}
+		return commentRepo.CreateComment(comment)
+	})
+	if err != nil {
+		return nil, err
+	}
+	response.Comment = comment
+	return response, nil
+}
+
+// checkAuthorAllowed rejects banned authors and authors posting faster than the rate limits allow.
+// Authors whose token was just issued could be anyone, so they are held to the per-minute limit of their
+// IP address; only bans issued with the IP address reach them
+func (s *CommentService) checkAuthorAllowed(commentRepo *repository.CommentRepository, authorKey, ipHash string, newAuthor bool) error {
+	now := time.Now()
+	ban, err := commentRepo.FindActiveBan(authorKey, ipHash, now)
+	if err == nil {
+		message := "COMMENT_BANNED: You are not allowed to comment"
+		if ban.ExpiresAt != nil {
+			message += " until " + ban.ExpiresAt.UTC().Format(time.RFC3339)
+		}
+		return errors.New(message)
+	}
+	if err != gorm.ErrRecordNotFound {
+		return err
+	}
+
+	// A new token has no comments yet, so its per-minute limit is counted by IP address
+	var recent int64
+	if newAuthor {
+		recent, err = commentRepo.CountRecentByIP(ipHash, now.Add(-time.Minute))
+	} else {
+		recent, err = commentRepo.CountRecentByAuthor(authorKey, now.Add(-time.Minute))
+	}
+	if err != nil {
+		return err
+	}
+	if recent >= int64(s.perMinute) {
+		return errors.New("RATE_LIMITED: Too many comments. Please wait a minute before posting again")
+	}
+	recent, err = commentRepo.CountRecentByIP(ipHash, now.Add(-time.Hour))
+	if err != nil {
+		return err
+	}
+	if recent >= int64(s.perHourPerIP) {
+		return errors.New("RATE_LIMITED: Too many comments from your network. Please try again later")
+	}
+	return nil
+}
+
+// builderAuthorKey is the author key of comments posted by a signed-in builder
+func builderAuthorKey(address string) string {
+	return "builder:" + address
+}
+
+// validCommentToken reports whether a token has the format issued by GenerateSecretToken
+func validCommentToken(token string) bool {
+	if len(token) != 48 {
+		return false
+	}
+	for _, r := range token {
+		if !((r >= '0' && r <= '9') || (r >= 'a' && r <= 'f')) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeleteComment deletes a comment for its author (identified by their comment token or builder account) or an admin.
+// The comment keeps its place in the thread without author and body while it has visible replies.
+func (s *CommentService) DeleteComment(projectID, commentID uint, author CommentAuthor, isAdmin bool) error {
+	comment, err := s.commentRepo.GetProjectComment(projectID, commentID)
+	if err != nil {
+		return err
+	}
+	if comment.Status == "deleted" {
+		return gorm.ErrRecordNotFound
+	}
+	isAuthor := utils.SecretTokenMatches(author.Token, comment.AuthorKey) ||
+		(author.BuilderAddress != "" && comment.AuthorKey == builderAuthorKey(author.BuilderAddress))
+	if !isAdmin && !isAuthor {
+		return errors.New("FORBIDDEN: Only the author can delete this comment")
+	}
+
+	_, err = s.commentRepo.UpdateCommentStatus(comment.ID, "deleted", comment.ModerationNote, true)
+	return err
+}
+
+// GetModerationComments lists comments of every status for moderators, newest first
+func (s *CommentService) GetModerationComments(req *GetModerationCommentsRequest) (*ModerationCommentsResponse, error) {
+	// Set defaults
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 50
+	}
+
+	filter := repository.CommentFilter{ProjectID: req.ProjectID, Status: req.Status}
+	comments, total, err := s.commentRepo.GetComments(filter, (req.Page-1)*req.Limit, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ModerationCommentsResponse{
+		Comments: comments,
+		Pagination: PaginationInfo{
+			Page:       req.Page,
+			Limit:      req.Limit,
+			Total:      int(total),
+			TotalPages: int(math.Ceil(float64(total) / float64(req.Limit))),
+		},
+	}, nil
+}
+
+// HideComment hides a visible comment from the public
+func (s *CommentService) HideComment(id uint, req *HideCommentRequest) (*models.Comment, error) {
+	comment, err := s.commentRepo.GetCommentByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Status != "visible" {
+		return nil, errors.New("INVALID_MODERATION: Only visible comments can be hidden")
+	}
+
+	var note *string
+	if reason := strings.TrimSpace(req.Reason); reason != "" {
+		note = &reason
+	}
+	return s.commentRepo.UpdateCommentStatus(id, "hidden", note, false)
+}
+
+// UnhideComment makes a hidden comment visible again
+func (s *CommentService) UnhideComment(id uint) (*models.Comment, error) {
+	comment, err := s.commentRepo.GetCommentByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Status != "hidden" {
+		return nil, errors.New("INVALID_MODERATION: Only hidden comments can be restored")
+	}
+	return s.commentRepo.UpdateCommentStatus(id, "visible", nil, false)
+}
+
+// PinComment pins or unpins a top-level comment; pinned comments are listed first
+func (s *CommentService) PinComment(id uint, pinned bool) (*models.Comment, error) {
+	comment, err := s.commentRepo.GetCommentByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.ParentID != nil {
+		return nil, errors.New("INVALID_MODERATION: Only top-level comments can be pinned")
+	}
+
+	if err := s.commentRepo.SetPinned(id, pinned); err != nil {
+		return nil, err
+	}
+	comment.Pinned = pinned
+	return comment, nil
+}
+
+// BanAuthor bans the author of a comment from commenting, optionally also their IP address
+func (s *CommentService) BanAuthor(commentID, adminID uint, req *BanAuthorRequest) (*BanAuthorResponse, error) {
+	comment, err := s.commentRepo.GetCommentByID(commentID)
+	if err != nil {
+		return nil, err
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, errors.New("INVALID_MODERATION: reason must not be empty")
+	}
+
+	ban := &models.CommentBan{
+		AuthorKey:  comment.AuthorKey,
+		AuthorName: comment.AuthorName,
+		CommentID:  &comment.ID,
+		Reason:     reason,
+	}
+	if req.IncludeIP {
+		ban.IPHash = comment.IPHash
+	}
+	if adminID != 0 {
+		ban.BannedBy = &adminID
+	}
+	if req.Days != nil {
+		expiresAt := time.Now().AddDate(0, 0, *req.Days)
+		ban.ExpiresAt = &expiresAt
+	}
+	if err := s.commentRepo.CreateBan(ban); err != nil {
+		return nil, err
+	}
+
+	response := &BanAuthorResponse{Ban: ban}
+	if req.HideComments {
+		hidden, err := s.commentRepo.HideAuthorComments(comment.AuthorKey, &reason)
+		if err != nil {
+			return nil, err
+		}
+		response.HiddenComments = hidden
+	}
+	return response, nil
+}
+
+// GetBans lists the bans that are in effect
+func (s *CommentService) GetBans() ([]models.CommentBan, error) {
+	return s.commentRepo.GetBans(time.Now())
+}
+
+// LiftBan removes a ban
+func (s *CommentService) LiftBan(id uint) error {
+	deleted, err := s.commentRepo.DeleteBan(id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ReconcileCommentCounts recomputes every project's comment counter and returns how many were corrected
+func (s *CommentService) ReconcileCommentCounts() (int64, error) {
+	return s.commentRepo.ReconcileCommentCounts()
+}
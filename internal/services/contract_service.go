@@ -0,0 +1,354 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+// maxDeclaredContracts limits how many contract addresses one submission may declare
+const maxDeclaredContracts = 10
+
+// maxHourlyPeriod is the longest period an hourly on-chain series may cover, keeping the series short
+const maxHourlyPeriod = 7 * 24 * time.Hour
+
+type ContractService struct {
+	contractRepo *repository.ContractRepository
+	projectRepo  *repository.ProjectRepository
+}
+
+func NewContractService(contractRepo *repository.ContractRepository, projectRepo *repository.ProjectRepository) *ContractService {
+	return &ContractService{
+		contractRepo: contractRepo,
+		projectRepo:  projectRepo,
+	}
+}
+
+// DeclareContractRequest represents the payload for adding a contract to a project
+type DeclareContractRequest struct {
+	Address string `json:"address" binding:"required"`
+	Label   string `json:"label" binding:"max=100"`
+}
+
+// VerifyContractRequest represents the payload for verifying a declared contract
+type VerifyContractRequest struct {
+	Name string `json:"name" binding:"max=100"` // Name of the analytics contract when it is created; defaults to the project name
+}
+
+// RejectContractRequest represents the payload for rejecting a declared contract
+type RejectContractRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// GetOnchainRequest represents the query parameters of a project's on-chain dashboard
+type GetOnchainRequest struct {
+	Period   string `form:"period" binding:"omitempty,oneof=24h 7d 30d 90d all"`
+	Interval string `form:"interval" binding:"omitempty,oneof=hour day week"`
+}
+
+// OnchainContract is a verified contract of a project with its indexed statistics
+type OnchainContract struct {
+	Address string                `json:"address"`
+	Label   string                `json:"label"`
+	Name    string                `json:"name"`
+	Stats   *models.ContractStats `json:"stats"` // Nil until the indexer has aggregated the contract
+}
+
+// ProjectOnchainResponse is a project's on-chain activity over a period
+type ProjectOnchainResponse struct {
+	ProjectID uint                       `json:"projectId"`
+	Period    string                     `json:"period"`
+	Interval  string                     `json:"interval"`
+	Contracts []OnchainContract          `json:"contracts"`
+	Totals    repository.ActivityPoint   `json:"totals"`
+	Series    []repository.ActivityPoint `json:"series"` // One point per interval, including empty ones
+}
+
+// onchainPeriods maps dashboard periods to their length; "all" has no start
+var onchainPeriods = map[string]time.Duration{
+	"24h": 24 * time.Hour,
+	"7d":  7 * 24 * time.Hour,
+	"30d": 30 * 24 * time.Hour,
+	"90d": 90 * 24 * time.Hour,
+}
+
+// GetProjectOnchain aggregates the transactions of a published project's verified contracts
+func (s *ContractService) GetProjectOnchain(projectID uint, req *GetOnchainRequest) (*ProjectOnchainResponse, error) {
+	// Set defaults
+	if req.Period == "" {
+		req.Period = "30d"
+	}
+	if req.Interval == "" {
+		req.Interval = "day"
+		if req.Period == "24h" {
+			req.Interval = "hour"
+		}
+	}
+
+	if length, ok := onchainPeriods[req.Period]; req.Interval == "hour" && (!ok || length > maxHourlyPeriod) {
+		return nil, errors.New("INVALID_INTERVAL: Hourly intervals are only available for periods of up to 7 days")
+	}
+
+	if _, err := s.projectRepo.GetPublishedProjectByID(projectID); err != nil {
+		return nil, err
+	}
+	declarations, err := s.contractRepo.GetProjectContracts(projectID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attach the indexed statistics of each linked contract
+	addresses := make([]string, len(declarations))
+	var contractIDs []string
+	for i, declaration := range declarations {
+		addresses[i] = declaration.Address
+		if declaration.ContractID != nil {
+			contractIDs = append(contractIDs, *declaration.ContractID)
+		}
+	}
+	stats, err := s.contractRepo.GetContractStats(contractIDs)
+	if err != nil {
+		return nil, err
+	}
+	statsByContract := make(map[string]*models.ContractStats, len(stats))
+	for i := range stats {
+		statsByContract[stats[i].ContractID] = &stats[i]
+	}
+
+	contracts := make([]OnchainContract, len(declarations))
+	for i, declaration := range declarations {
+		contracts[i] = OnchainContract{Address: declaration.Address, Label: declaration.Label}
+		if declaration.Contract != nil {
+			contracts[i].Name = declaration.Contract.Name
+			contracts[i].Stats = statsByContract[declaration.Contract.ID]
+		}
+	}
+
+	var since *time.Time
+	if length, ok := onchainPeriods[req.Period]; ok {
+		start := time.Now().Add(-length)
+		since = &start
+	}
+	totals, err := s.contractRepo.GetActivityTotals(addresses, since)
+	if err != nil {
+		return nil, err
+	}
+	points, err := s.contractRepo.GetActivity(addresses, since, req.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProjectOnchainResponse{
+		ProjectID: projectID,
+		Period:    req.Period,
+		Interval:  req.Interval,
+		Contracts: contracts,
+		Totals:    *totals,
+		Series:    fillActivityGaps(points, since, req.Interval, time.Now()),
+	}, nil
+}
+
+// fillActivityGaps adds empty points for intervals without transactions so charts have a continuous axis
+func fillActivityGaps(points []repository.ActivityPoint, since *time.Time, interval string, now time.Time) []repository.ActivityPoint {
+	var start time.Time
+	switch {
+	case since != nil:
+		start = truncateInterval(*since, interval)
+	case len(points) > 0:
+		start = points[0].Bucket
+	default:
+		return points
+	}
+
+	byBucket := make(map[time.Time]repository.ActivityPoint, len(points))
+	for _, point := range points {
+		byBucket[point.Bucket.UTC()] = point
+	}
+
+	series := []repository.ActivityPoint{}
+	end := truncateInterval(now, interval)
+	for bucket := start.UTC(); !bucket.After(end); bucket = nextInterval(bucket, interval) {
+		point, ok := byBucket[bucket]
+		if !ok {
+			point = repository.ActivityPoint{Bucket: bucket}
+		}
+		series = append(series, point)
+	}
+	return series
+}
+
+// truncateInterval rounds a time down the same way as PostgreSQL's date_trunc in UTC
+func truncateInterval(t time.Time, interval string) time.Time {
+	t = t.UTC()
+	switch interval {
+	case "hour":
+		return t.Truncate(time.Hour)
+	case "week":
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		offset := (int(day.Weekday()) + 6) % 7 // Weeks start on Monday
+		return day.AddDate(0, 0, -offset)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// nextInterval returns the start of the following interval
+func nextInterval(t time.Time, interval string) time.Time {
+	switch interval {
+	case "hour":
+		return t.Add(time.Hour)
+	case "week":
+		return t.AddDate(0, 0, 7)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+// GetContractDeclarations lists contract declarations for review, optionally by status
+func (s *ContractService) GetContractDeclarations(status string) ([]repository.ProjectContractItem, error) {
+	return s.contractRepo.GetContractDeclarations(status)
+}
+
+// GetProjectContracts lists every declaration of a project, including pending and rejected ones
+func (s *ContractService) GetProjectContracts(projectID uint) ([]models.ProjectContract, error) {
+	if _, err := s.projectRepo.GetProjectByID(projectID); err != nil {
+		return nil, err
+	}
+	return s.contractRepo.GetProjectContracts(projectID, false)
+}
+
+// AddProjectContract adds a contract to a project on behalf of an admin; it is verified right away
+func (s *ContractService) AddProjectContract(projectID, adminID uint, req *DeclareContractRequest) (*models.ProjectContract, error) {
+	address := utils.NormalizeAddress(req.Address)
+	if address == "" {
+		return nil, errors.New("INVALID_CONTRACT_ADDRESS: " + req.Address + " is not a 0x-prefixed address of 40 hex characters")
+	}
+	project, err := s.projectRepo.GetProjectByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	declaration := &models.ProjectContract{
+		ProjectID: project.ID,
+		Address:   address,
+		Label:     strings.TrimSpace(req.Label),
+		Status:    "pending",
+	}
+	if err := s.contractRepo.CreateProjectContract(declaration); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("DUPLICATE_CONTRACT: Project already declares this contract")
+		}
+		return nil, err
+	}
+
+	if err := s.verify(project, declaration, adminID, ""); err != nil {
+		return nil, err
+	}
+	return declaration, nil
+}
+
+// VerifyContract confirms that a declared contract belongs to the project and links it to its analytics contract
+func (s *ContractService) VerifyContract(projectID, declarationID, adminID uint, req *VerifyContractRequest) (*models.ProjectContract, error) {
+	project, err := s.projectRepo.GetProjectByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	declaration, err := s.contractRepo.GetProjectContractByID(projectID, declarationID)
+	if err != nil {
+		return nil, err
+	}
+	if declaration.Status == "verified" {
+		return nil, errors.New("ALREADY_VERIFIED: Contract is already verified")
+	}
+
+	if err := s.verify(project, declaration, adminID, strings.TrimSpace(req.Name)); err != nil {
+		return nil, err
+	}
+	return declaration, nil
+}
+
+// verify marks a declaration as verified and links it to the analytics contract with its address
+func (s *ContractService) verify(project *models.Project, declaration *models.ProjectContract, adminID uint, name string) error {
+	if name == "" {
+		name = project.Name
+	}
+	contract, err := s.contractRepo.FindOrCreateContract(declaration.Address, name, project.Logo)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	declaration.Status = "verified"
+	declaration.ContractID = &contract.ID
+	declaration.Contract = contract
+	declaration.RejectionReason = nil
+	declaration.VerifiedAt = &now
+	if adminID != 0 {
+		declaration.VerifiedBy = &adminID
+	}
+	return s.contractRepo.UpdateProjectContract(declaration)
+}
+
+// RejectContract marks a declared contract as not belonging to the project
+func (s *ContractService) RejectContract(projectID, declarationID uint, req *RejectContractRequest) (*models.ProjectContract, error) {
+	declaration, err := s.contractRepo.GetProjectContractByID(projectID, declarationID)
+	if err != nil {
+		return nil, err
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, errors.New("INVALID_CONTRACT: reason must not be empty")
+	}
+
+	declaration.Status = "rejected"
+	declaration.ContractID = nil
+	declaration.Contract = nil
+	declaration.RejectionReason = &reason
+	declaration.VerifiedBy = nil
+	declaration.VerifiedAt = nil
+	if err := s.contractRepo.UpdateProjectContract(declaration); err != nil {
+		return nil, err
+	}
+	return declaration, nil
+}
+
+// DeleteProjectContract removes a contract declaration from a project
+func (s *ContractService) DeleteProjectContract(projectID, declarationID uint) error {
+	declaration, err := s.contractRepo.GetProjectContractByID(projectID, declarationID)
+	if err != nil {
+		return err
+	}
+	return s.contractRepo.DeleteProjectContract(declaration.ID)
+}
+
+// normalizeContractAddresses validates declared addresses and returns them lowercased without duplicates
+func normalizeContractAddresses(values []string) ([]string, error) {
+	addresses := []string{}
+	for _, value := range utils.RemoveEmpty(values) {
+		address := utils.NormalizeAddress(value)
+		if address == "" {
+			return nil, errors.New("INVALID_CONTRACT_ADDRESS: " + value + " is not a 0x-prefixed address of 40 hex characters")
+		}
+		addresses = append(addresses, address)
+	}
+	addresses = utils.RemoveDuplicates(addresses)
+	if len(addresses) > maxDeclaredContracts {
+		return nil, errors.New("INVALID_CONTRACT_ADDRESS: At most 10 contract addresses can be declared")
+	}
+	return addresses, nil
+}
+
+// pendingContracts turns the addresses declared by a submission into declarations awaiting verification
+func pendingContracts(addresses []string) []models.ProjectContract {
+	contracts := make([]models.ProjectContract, len(addresses))
+	for i, address := range addresses {
+		contracts[i] = models.ProjectContract{Address: address, Status: "pending"}
+	}
+	return contracts
+}
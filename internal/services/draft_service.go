@@ -0,0 +1,196 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/utils"
+
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+type DraftService struct {
+	draftRepo      *repository.DraftRepository
+	projectService *ProjectService
+	ttl            time.Duration
+}
+
+func NewDraftService(draftRepo *repository.DraftRepository, projectService *ProjectService, ttl time.Duration) *DraftService {
+	return &DraftService{
+		draftRepo:      draftRepo,
+		projectService: projectService,
+		ttl:            ttl,
+	}
+}
+
+// SaveDraftRequest represents a partial submission; every field is optional
+type SaveDraftRequest struct {
+	PhotoLink         string                   `json:"photoLink"`
+	ProjectName       string                   `json:"projectName"`
+	Description       string                   `json:"description"`
+	Event             string                   `json:"event"`
+	Categories        []string                 `json:"categories"`
+	TeamMembers       []models.TeamMemberInput `json:"teamMembers"`
+	GithubLink        *string                  `json:"githubLink,omitempty"`
+	WebsiteLink       *string                  `json:"websiteLink,omitempty"`
+	PlayLink          string                   `json:"playLink"`
+	HowToPlay         string                   `json:"howToPlay"`
+	AdditionalNotes   *string                  `json:"additionalNotes,omitempty"`
+	ExtraFields       map[string]interface{}   `json:"extraFields,omitempty"`
+	ContractAddresses []string                 `json:"contractAddresses,omitempty"`
+}
+
+// DraftResponse represents a saved draft returned to the client
+type DraftResponse struct {
+	Success               bool             `json:"success"`
+	DraftID               string           `json:"draftId"`
+	Data                  SaveDraftRequest `json:"data"`
+	FinalizedSubmissionID *string          `json:"finalizedSubmissionId,omitempty"`
+	ExpiresAt             time.Time        `json:"expiresAt"`
+	UpdatedAt             time.Time        `json:"updatedAt"`
+}
+
+// CreateDraft stores a new draft and returns its token
+func (s *DraftService) CreateDraft(req *SaveDraftRequest) (*DraftResponse, error) {
+	draftID, err := utils.GenerateDraftToken()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	draft := &models.SubmissionDraft{
+		ID:        draftID,
+		Data:      string(data),
+		ExpiresAt: time.Now().Add(s.ttl),
+	}
+	if err := s.draftRepo.CreateDraft(draft); err != nil {
+		return nil, err
+	}
+
+	return s.toDraftResponse(draft)
+}
+
+// GetDraft retrieves an active draft by its token
+func (s *DraftService) GetDraft(draftID string) (*DraftResponse, error) {
+	draft, err := s.draftRepo.GetActiveDraftByID(draftID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toDraftResponse(draft)
+}
+
+// UpdateDraft replaces the saved data of a draft and extends its expiry
+func (s *DraftService) UpdateDraft(draftID string, req *SaveDraftRequest) (*DraftResponse, error) {
+	draft, err := s.draftRepo.GetActiveDraftByID(draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	if draft.FinalizedSubmissionID != nil {
+		return nil, errors.New("DRAFT_ALREADY_FINALIZED: Draft has already been submitted as " + *draft.FinalizedSubmissionID)
+	}
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	draft.Data = string(data)
+	draft.ExpiresAt = time.Now().Add(s.ttl)
+	if err := s.draftRepo.UpdateDraft(draft); err != nil {
+		return nil, err
+	}
+
+	return s.toDraftResponse(draft)
+}
+
+// FinalizeDraft validates a draft as a full submission and converts it into a Submission
+func (s *DraftService) FinalizeDraft(draftID string) (*SubmitProjectResponse, error) {
+	draft, err := s.draftRepo.GetActiveDraftByID(draftID)
+	if err != nil {
+		return nil, err
+	}
+
+	if draft.FinalizedSubmissionID != nil {
+		return nil, errors.New("DRAFT_ALREADY_FINALIZED: Draft has already been submitted as " + *draft.FinalizedSubmissionID)
+	}
+
+	// Decode the draft into a full submission request
+	var req SubmitProjectRequest
+	if err := json.Unmarshal([]byte(draft.Data), &req); err != nil {
+		return nil, err
+	}
+
+	// Enforce the same required fields as POST /submissions
+	if err := binding.Validator.ValidateStruct(&req); err != nil {
+		return nil, errors.New("INCOMPLETE_DRAFT: " + err.Error())
+	}
+
+	// Submit through the regular pipeline (runs validateSubmissionRequest) and mark the draft as finalized in the
+	// same transaction. A concurrent finalization waits on the draft row and then finds it finalized, which rolls
+	// back its submission, so a draft is only ever submitted once
+	var response *SubmitProjectResponse
+	err = s.draftRepo.Transaction(func(tx *gorm.DB) error {
+		var err error
+		response, err = s.projectService.WithTx(tx).SubmitProject(&req)
+		if err != nil {
+			return err
+		}
+
+		finalized, err := s.draftRepo.WithTx(tx).MarkDraftFinalized(draft.ID, response.SubmissionID)
+		if err != nil {
+			return err
+		}
+		if !finalized {
+			return errors.New("DRAFT_ALREADY_FINALIZED: Draft has already been submitted")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// RunCleanup periodically deletes expired drafts until the process exits
+func (s *DraftService) RunCleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := s.draftRepo.DeleteExpiredDrafts(time.Now())
+		if err != nil {
+			log.Printf("Failed to clean up expired drafts: %v", err)
+		} else if deleted > 0 {
+			log.Printf("Deleted %d expired submission drafts", deleted)
+		}
+		<-ticker.C
+	}
+}
+
+// toDraftResponse converts a stored draft into its response format
+func (s *DraftService) toDraftResponse(draft *models.SubmissionDraft) (*DraftResponse, error) {
+	var data SaveDraftRequest
+	if err := json.Unmarshal([]byte(draft.Data), &data); err != nil {
+		return nil, err
+	}
+
+	return &DraftResponse{
+		Success:               true,
+		DraftID:               draft.ID,
+		Data:                  data,
+		FinalizedSubmissionID: draft.FinalizedSubmissionID,
+		ExpiresAt:             draft.ExpiresAt,
+		UpdatedAt:             draft.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,253 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"monad-devhub-be/internal/jsonschema"
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+type EventService struct {
+	eventRepo *repository.EventRepository
+}
+
+func NewEventService(eventRepo *repository.EventRepository) *EventService {
+	return &EventService{
+		eventRepo: eventRepo,
+	}
+}
+
+// EventRequest represents the payload for creating or updating an event
+type EventRequest struct {
+	Slug               string          `json:"slug"` // Derived from the name when empty
+	Name               string          `json:"name" binding:"required"`
+	Description        string          `json:"description"`
+	StartDate          *time.Time      `json:"startDate"`
+	EndDate            *time.Time      `json:"endDate"`
+	SubmissionsOpenAt  *time.Time      `json:"submissionsOpenAt"`
+	SubmissionsCloseAt *time.Time      `json:"submissionsCloseAt"`
+	Status             string          `json:"status" binding:"omitempty,oneof=draft active closed archived"`
+	FormSchema         json.RawMessage `json:"formSchema"` // JSON Schema of extra submission fields; null for none
+}
+
+// EventResponse represents an event together with whether it currently takes submissions
+type EventResponse struct {
+	models.Event
+	AcceptingSubmissions bool `json:"acceptingSubmissions"`
+}
+
+// GetEvents lists events; drafts are only included for admins
+func (s *EventService) GetEvents(includeDrafts bool) ([]EventResponse, error) {
+	events, err := s.eventRepo.GetEvents(includeDrafts)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	response := make([]EventResponse, len(events))
+	for i, event := range events {
+		response[i] = EventResponse{
+			Event:                event,
+			AcceptingSubmissions: acceptsSubmissions(&event, now),
+		}
+	}
+	return response, nil
+}
+
+// GetEvent retrieves an event by numeric ID or slug; drafts are only visible to admins
+func (s *EventService) GetEvent(idOrSlug string, includeDrafts bool) (*EventResponse, error) {
+	event, err := s.lookupEvent(idOrSlug)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status == "draft" && !includeDrafts {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &EventResponse{
+		Event:                *event,
+		AcceptingSubmissions: acceptsSubmissions(event, time.Now()),
+	}, nil
+}
+
+// FormSchemaResponse is the schema of an event's extra submission fields
+type FormSchemaResponse struct {
+	EventID    uint        `json:"eventId"`
+	Slug       string      `json:"slug"`
+	Name       string      `json:"name"`
+	FormSchema models.JSON `json:"formSchema"` // null when the event has no extra fields
+}
+
+// GetFormSchema retrieves the extra field schema of a non-draft event by numeric ID or slug
+func (s *EventService) GetFormSchema(idOrSlug string) (*FormSchemaResponse, error) {
+	event, err := s.lookupEvent(idOrSlug)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status == "draft" {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &FormSchemaResponse{
+		EventID:    event.ID,
+		Slug:       event.Slug,
+		Name:       event.Name,
+		FormSchema: event.FormSchema,
+	}, nil
+}
+
+// lookupEvent finds an event by numeric ID or slug
+func (s *EventService) lookupEvent(idOrSlug string) (*models.Event, error) {
+	if id, err := strconv.ParseUint(idOrSlug, 10, 32); err == nil {
+		return s.eventRepo.GetEventByID(uint(id))
+	}
+	return s.eventRepo.GetEventBySlug(idOrSlug)
+}
+
+// findEventByNameOrSlug finds an event by its display name, falling back to its slug
+func findEventByNameOrSlug(eventRepo *repository.EventRepository, nameOrSlug string) (*models.Event, error) {
+	event, err := eventRepo.GetEventByName(nameOrSlug)
+	if err == gorm.ErrRecordNotFound {
+		event, err = eventRepo.GetEventBySlug(nameOrSlug)
+	}
+	return event, err
+}
+
+// CreateEvent creates a new event
+func (s *EventService) CreateEvent(req *EventRequest) (*models.Event, error) {
+	event := &models.Event{}
+	if err := applyEventRequest(event, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.eventRepo.CreateEvent(event); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("DUPLICATE_EVENT: An event with this name or slug already exists")
+		}
+		return nil, err
+	}
+	return event, nil
+}
+
+// UpdateEvent replaces an event's details; renaming also renames it on linked projects and submissions
+func (s *EventService) UpdateEvent(id uint, req *EventRequest) (*models.Event, error) {
+	event, err := s.eventRepo.GetEventByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	previousName := event.Name
+	if err := applyEventRequest(event, req); err != nil {
+		return nil, err
+	}
+
+	publicFields, err := publicFieldNames(event)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.eventRepo.UpdateEvent(event, previousName, publicFields); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("DUPLICATE_EVENT: An event with this name or slug already exists")
+		}
+		return nil, err
+	}
+	return event, nil
+}
+
+// DeleteEvent deletes an event that no project, submission or award refers to
+func (s *EventService) DeleteEvent(id uint) error {
+	event, err := s.eventRepo.GetEventByID(id)
+	if err != nil {
+		return err
+	}
+
+	references, err := s.eventRepo.CountEventReferences(event.ID)
+	if err != nil {
+		return err
+	}
+	if references > 0 {
+		return errors.New("EVENT_IN_USE: Event has projects, submissions or awards; archive it instead")
+	}
+
+	return s.eventRepo.DeleteEvent(event)
+}
+
+// applyEventRequest validates the request and copies it onto the event
+func applyEventRequest(event *models.Event, req *EventRequest) error {
+	slug := req.Slug
+	if slug == "" {
+		slug = utils.Slugify(req.Name)
+	}
+	if !utils.ValidateSlug(slug) {
+		return errors.New("INVALID_EVENT: Slug must contain only lowercase letters, digits and single dashes")
+	}
+	if req.StartDate != nil && req.EndDate != nil && req.EndDate.Before(*req.StartDate) {
+		return errors.New("INVALID_EVENT: endDate must not be before startDate")
+	}
+	if req.SubmissionsOpenAt != nil && req.SubmissionsCloseAt != nil && !req.SubmissionsCloseAt.After(*req.SubmissionsOpenAt) {
+		return errors.New("INVALID_EVENT: submissionsCloseAt must be after submissionsOpenAt")
+	}
+
+	status := req.Status
+	if status == "" {
+		status = "active"
+	}
+
+	formSchema, err := parseFormSchema(req.FormSchema)
+	if err != nil {
+		return err
+	}
+
+	event.Slug = slug
+	event.Name = req.Name
+	event.Description = req.Description
+	event.StartDate = req.StartDate
+	event.EndDate = req.EndDate
+	event.SubmissionsOpenAt = req.SubmissionsOpenAt
+	event.SubmissionsCloseAt = req.SubmissionsCloseAt
+	event.Status = status
+	event.FormSchema = formSchema
+	return nil
+}
+
+// parseFormSchema validates an event's form schema and returns it compacted; null means no extra fields
+func parseFormSchema(raw json.RawMessage) (models.JSON, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	schema, err := jsonschema.Parse(raw)
+	if err == nil {
+		err = schema.MustBeObject()
+	}
+	if err != nil {
+		return nil, errors.New("INVALID_FORM_SCHEMA: " + err.Error())
+	}
+
+	compact, err := jsonschema.Compact(raw)
+	if err != nil {
+		return nil, err
+	}
+	return models.JSON(compact), nil
+}
+
+// acceptsSubmissions reports whether the event takes new submissions at the given time
+func acceptsSubmissions(event *models.Event, now time.Time) bool {
+	if event.Status != "active" {
+		return false
+	}
+	if event.SubmissionsOpenAt != nil && now.Before(*event.SubmissionsOpenAt) {
+		return false
+	}
+	if event.SubmissionsCloseAt != nil && !now.Before(*event.SubmissionsCloseAt) {
+		return false
+	}
+	return true
+}
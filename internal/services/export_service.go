@@ -0,0 +1,201 @@
+package services
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"monad-devhub-be/internal/export"
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+)
+
+// exportBatchSize is how many rows are loaded from the database at a time while exporting
+const exportBatchSize = 200
+
+type ExportService struct {
+	submissionRepo *repository.SubmissionRepository
+	projectRepo    *repository.ProjectRepository
+}
+
+func NewExportService(submissionRepo *repository.SubmissionRepository, projectRepo *repository.ProjectRepository) *ExportService {
+	return &ExportService{
+		submissionRepo: submissionRepo,
+		projectRepo:    projectRepo,
+	}
+}
+
+// SubmissionExportTeamSize returns how many team member column groups a submissions export needs
+func (s *ExportService) SubmissionExportTeamSize(req *GetSubmissionsRequest) (int, error) {
+	return s.submissionRepo.GetMaxTeamSize(req.Filter())
+}
+
+// ProjectExportTeamSize returns how many team member column groups a projects export needs
+func (s *ExportService) ProjectExportTeamSize() (int, error) {
+	return s.projectRepo.GetMaxTeamSize()
+}
+
+// ExportSubmissions streams submissions matching the request filters to the writer
+func (s *ExportService) ExportSubmissions(w export.RowWriter, req *GetSubmissionsRequest, teamSize int) error {
+	header := []string{
+		"Submission ID", "Project Name", "Event", "Categories", "Status",
+		"Submitted At", "Review Started At", "Reviewed At", "Published At", "Reviewer ID",
+		"Feedback", "Changes Requested", "Description", "How To Play",
+		"Play Link", "GitHub", "Website", "Photo", "Additional Notes", "Extra Fields", "Approved Project ID",
+	}
+	for i := 1; i <= teamSize; i++ {
+		n := strconv.Itoa(i)
+		header = append(header, "Team Member "+n+" Name", "Team Member "+n+" Twitter")
+	}
+	if err := w.WriteRow(header); err != nil {
+		return err
+	}
+
+	err := s.submissionRepo.StreamSubmissions(req.Filter(), exportBatchSize, func(batch []models.Submission) error {
+		for _, submission := range batch {
+			row := []string{
+				submission.ID,
+				submission.ProjectName,
+				submission.Event,
+				strings.Join(submission.Categories, "; "),
+				submission.Status,
+				formatExportTime(&submission.SubmittedAt),
+				formatExportTime(submission.ReviewStartedAt),
+				formatExportTime(submission.ReviewedAt),
+				formatExportTime(submission.PublishedAt),
+				formatExportUint(submission.ReviewerID),
+				formatExportString(submission.Feedback),
+				strings.Join(submission.ChangesRequested, "; "),
+				submission.Description,
+				submission.HowToPlay,
+				submission.PlayLink,
+				formatExportString(submission.GithubLink),
+				formatExportString(submission.WebsiteLink),
+				submission.PhotoLink,
+				formatExportString(submission.AdditionalNotes),
+				formatExportExtraFields(submission.ExtraFields),
+				formatExportUint(submission.ApprovedProjectID),
+			}
+
+			// Flatten team members into fixed column pairs
+			var teamMembers []models.TeamMemberInput
+			if submission.TeamMembers != "" {
+				json.Unmarshal([]byte(submission.TeamMembers), &teamMembers)
+			}
+			for i := 0; i < teamSize; i++ {
+				if i < len(teamMembers) {
+					row = append(row, teamMembers[i].Name, teamMembers[i].Twitter)
+				} else {
+					row = append(row, "", "")
+				}
+			}
+
+			if err := w.WriteRow(row); err != nil {
+				return err
+			}
+		}
+		return w.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.Close()
+}
+
+// ExportProjects streams all projects, including unpublished ones, to the writer
+func (s *ExportService) ExportProjects(w export.RowWriter, teamSize int) error {
+	header := []string{
+		"Project ID", "Name", "Event", "Categories", "Awards", "Likes", "Comments",
+		"Published At", "Scheduled Publish At", "Play URL", "GitHub", "Website", "Logo",
+		"Submission ID", "Description", "How To Play", "Extra Fields",
+	}
+	for i := 1; i <= teamSize; i++ {
+		n := strconv.Itoa(i)
+		header = append(header, "Team Member "+n+" Name", "Team Member "+n+" Twitter", "Team Member "+n+" Image")
+	}
+	if err := w.WriteRow(header); err != nil {
+		return err
+	}
+
+	err := s.projectRepo.StreamProjects(exportBatchSize, func(batch []models.Project) error {
+		for _, project := range batch {
+			row := []string{
+				strconv.FormatUint(uint64(project.ID), 10),
+				project.Name,
+				project.Event,
+				strings.Join(project.Categories, "; "),
+				strings.Join(awardNames(&project), "; "),
+				strconv.Itoa(project.Likes),
+				strconv.Itoa(project.Comments),
+				formatExportTime(project.PublishedAt),
+				formatExportTime(project.PublishAt),
+				project.PlayURL,
+				formatExportString(project.GithubURL),
+				formatExportString(project.WebsiteURL),
+				project.Logo,
+				formatExportString(project.SubmissionID),
+				project.Description,
+				project.HowToPlay,
+				formatExportExtraFields(project.ExtraFields),
+			}
+
+			// Flatten team members into fixed column groups
+			for i := 0; i < teamSize; i++ {
+				if i < len(project.TeamMembers) {
+					member := project.TeamMembers[i]
+					row = append(row, member.Name, member.Twitter, member.Image)
+				} else {
+					row = append(row, "", "", "")
+				}
+			}
+
+			if err := w.WriteRow(row); err != nil {
+				return err
+			}
+		}
+		return w.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.Close()
+}
+
+// formatExportTime formats an optional timestamp for spreadsheets
+func formatExportTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02T15:04:05Z")
+}
+
+// formatExportString dereferences an optional string
+func formatExportString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// formatExportExtraFields writes custom field values as a JSON object
+func formatExportExtraFields(fields models.ExtraFields) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// formatExportUint formats an optional ID
+func formatExportUint(value *uint) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatUint(uint64(*value), 10)
+}
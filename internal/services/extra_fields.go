@@ -0,0 +1,89 @@
+package services
+
+import (
+	"fmt"
+
+	"monad-devhub-be/internal/jsonschema"
+	"monad-devhub-be/internal/models"
+)
+
+// FieldValidationError reports invalid extra fields together with a message per field
+type FieldValidationError struct {
+	Fields []jsonschema.FieldError
+}
+
+// Error keeps the "CODE: message" form used for all service errors
+func (e *FieldValidationError) Error() string {
+	return fmt.Sprintf("INVALID_EXTRA_FIELDS: %d extra field(s) are invalid", len(e.Fields))
+}
+
+// eventFormSchema parses the form schema of an event; events without one return nil
+func eventFormSchema(event *models.Event) (*jsonschema.Schema, error) {
+	if len(event.FormSchema) == 0 {
+		return nil, nil
+	}
+	schema, err := jsonschema.Parse(event.FormSchema)
+	if err != nil {
+		return nil, fmt.Errorf("stored form schema of event %d is invalid: %w", event.ID, err)
+	}
+	return schema, nil
+}
+
+// validateExtraFields checks submitted extra field values against the event's form schema
+func validateExtraFields(event *models.Event, values map[string]interface{}) error {
+	schema, err := eventFormSchema(event)
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		if len(values) > 0 {
+			return &FieldValidationError{Fields: []jsonschema.FieldError{
+				{Field: "extraFields", Message: "this event does not accept extra fields"},
+			}}
+		}
+		return nil
+	}
+
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	if fieldErrors := schema.Validate(values); len(fieldErrors) > 0 {
+		for i := range fieldErrors {
+			if fieldErrors[i].Field == "" {
+				fieldErrors[i].Field = "extraFields"
+			} else {
+				fieldErrors[i].Field = "extraFields." + fieldErrors[i].Field
+			}
+		}
+		return &FieldValidationError{Fields: fieldErrors}
+	}
+	return nil
+}
+
+// publicFieldNames returns the extra fields the event's form schema marks as public with "x-public"
+func publicFieldNames(event *models.Event) ([]string, error) {
+	schema, err := eventFormSchema(event)
+	if err != nil || schema == nil {
+		return nil, err
+	}
+	return schema.PublicProperties(), nil
+}
+
+// publicExtraFields returns the extra field values the event shows publicly; all other answers are private
+func publicExtraFields(event *models.Event, values models.ExtraFields) (models.ExtraFields, error) {
+	names, err := publicFieldNames(event)
+	if err != nil {
+		return nil, err
+	}
+
+	var public models.ExtraFields
+	for _, name := range names {
+		if value, ok := values[name]; ok {
+			if public == nil {
+				public = models.ExtraFields{}
+			}
+			public[name] = value
+		}
+	}
+	return public, nil
+}
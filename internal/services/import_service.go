@@ -0,0 +1,416 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"monad-devhub-be/internal/importer"
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+type ImportService struct {
+	projectService *ProjectService
+	submissionRepo *repository.SubmissionRepository
+	projectRepo    *repository.ProjectRepository
+	awardRepo      *repository.AwardRepository
+}
+
+func NewImportService(projectService *ProjectService, submissionRepo *repository.SubmissionRepository, projectRepo *repository.ProjectRepository, awardRepo *repository.AwardRepository) *ImportService {
+	return &ImportService{
+		projectService: projectService,
+		submissionRepo: submissionRepo,
+		projectRepo:    projectRepo,
+		awardRepo:      awardRepo,
+	}
+}
+
+// Import targets
+const (
+	ImportTargetSubmissions = "submissions" // Rows become pending submissions that go through review
+	ImportTargetProjects    = "projects"    // Rows become approved, published projects
+)
+
+// Import row outcomes
+const (
+	ImportRowCreated     = "created"
+	ImportRowWouldCreate = "would_create" // Dry run: the row is valid and would be created
+	ImportRowExisting    = "existing"     // Already imported under the same external ID; skipped
+	ImportRowDuplicate   = "duplicate"    // Clashes with another row or an existing project name
+	ImportRowInvalid     = "invalid"
+	ImportRowFailed      = "failed"
+)
+
+// maxImportRows bounds the size of a single import
+const maxImportRows = 5000
+
+// ImportRequest describes one import run
+type ImportRequest struct {
+	Source  string // External platform name, e.g. "devpost"; scopes the external IDs
+	Target  string
+	DryRun  bool
+	Mapping *importer.Mapping
+	Records []importer.Record
+}
+
+// ImportRowResult reports what happened to a single row
+type ImportRowResult struct {
+	Row          int      `json:"row"` // 1-based data row, not counting the CSV header
+	ExternalID   string   `json:"externalId"`
+	ProjectName  string   `json:"projectName"`
+	Status       string   `json:"status"`
+	SubmissionID string   `json:"submissionId,omitempty"`
+	ProjectID    *uint    `json:"projectId,omitempty"`
+	Errors       []string `json:"errors,omitempty"`
+}
+
+// ImportReport summarizes an import run
+type ImportReport struct {
+	Source     string            `json:"source"`
+	Target     string            `json:"target"`
+	DryRun     bool              `json:"dryRun"`
+	Total      int               `json:"total"`
+	Created    int               `json:"created"`
+	Existing   int               `json:"existing"`
+	Duplicates int               `json:"duplicates"`
+	Invalid    int               `json:"invalid"`
+	Failed     int               `json:"failed"`
+	Rows       []ImportRowResult `json:"rows"`
+}
+
+// HasProblems reports whether any row was rejected
+func (r *ImportReport) HasProblems() bool {
+	return r.Duplicates > 0 || r.Invalid > 0 || r.Failed > 0
+}
+
+// Import validates every row and, unless it is a dry run, creates the valid ones.
+// Rows are created independently; rows already imported under the same external ID are skipped,
+// so a partially failed import can simply be run again.
+func (s *ImportService) Import(req *ImportRequest) (*ImportReport, error) {
+	source := strings.ToLower(strings.TrimSpace(req.Source))
+	if source == "" {
+		return nil, errors.New("INVALID_IMPORT: source is required")
+	}
+	if req.Target != ImportTargetSubmissions && req.Target != ImportTargetProjects {
+		return nil, fmt.Errorf("INVALID_IMPORT: target must be %s or %s", ImportTargetSubmissions, ImportTargetProjects)
+	}
+	if req.Mapping == nil {
+		return nil, errors.New("INVALID_IMPORT: mapping is required")
+	}
+	if err := req.Mapping.Validate(); err != nil {
+		return nil, errors.New("INVALID_IMPORT: " + err.Error())
+	}
+	if len(req.Records) > maxImportRows {
+		return nil, fmt.Errorf("INVALID_IMPORT: at most %d rows can be imported at once", maxImportRows)
+	}
+
+	report := &ImportReport{
+		Source: source,
+		Target: req.Target,
+		DryRun: req.DryRun,
+		Total:  len(req.Records),
+		Rows:   make([]ImportRowResult, 0, len(req.Records)),
+	}
+
+	seenExternalIDs := make(map[string]int)
+	seenNames := make(map[string]int)
+
+	for i, record := range req.Records {
+		entry := req.Mapping.Apply(record)
+		result := ImportRowResult{
+			Row:         i + 1,
+			ExternalID:  entry.ExternalID,
+			ProjectName: entry.ProjectName,
+		}
+
+		if err := s.importRow(source, req, entry, &result, seenExternalIDs, seenNames); err != nil {
+			result.Status = ImportRowFailed
+			result.Errors = append(result.Errors, err.Error())
+		}
+
+		switch result.Status {
+		case ImportRowCreated, ImportRowWouldCreate:
+			report.Created++
+		case ImportRowExisting:
+			report.Existing++
+		case ImportRowDuplicate:
+			report.Duplicates++
+		case ImportRowInvalid:
+			report.Invalid++
+		case ImportRowFailed:
+			report.Failed++
+		}
+		report.Rows = append(report.Rows, result)
+	}
+
+	return report, nil
+}
+
+// importRow checks a single row and creates it when appropriate, recording the outcome in result
+func (s *ImportService) importRow(source string, req *ImportRequest, entry *importer.Entry, result *ImportRowResult, seenExternalIDs, seenNames map[string]int) error {
+	if entry.ExternalID == "" {
+		result.Status = ImportRowInvalid
+		result.Errors = []string{"MISSING_EXTERNAL_ID: Column " + req.Mapping.ExternalID + " is empty"}
+		return nil
+	}
+	if firstRow, ok := seenExternalIDs[entry.ExternalID]; ok {
+		result.Status = ImportRowDuplicate
+		result.Errors = []string{fmt.Sprintf("DUPLICATE_EXTERNAL_ID: Same external ID as row %d", firstRow)}
+		return nil
+	}
+	seenExternalIDs[entry.ExternalID] = result.Row
+
+	// Rows imported by an earlier run are skipped, which keeps re-running an import idempotent
+	found, err := s.findExisting(source, req.Target, entry.ExternalID, result)
+	if err != nil || found {
+		return err
+	}
+
+	submitReq := toSubmitProjectRequest(entry)
+	if err := s.convertExtraFields(entry, submitReq); err != nil {
+		return err
+	}
+	event, errs := s.validateEntry(submitReq)
+	if len(errs) > 0 {
+		result.Status = ImportRowInvalid
+		result.Errors = errs
+		return nil
+	}
+	entry.Event = submitReq.Event
+
+	// Project names must be unique within the file and against existing projects and submissions
+	nameKey := strings.ToLower(entry.ProjectName)
+	if firstRow, ok := seenNames[nameKey]; ok {
+		result.Status = ImportRowDuplicate
+		result.Errors = []string{fmt.Sprintf("DUPLICATE_PROJECT_NAME: Same project name as row %d", firstRow)}
+		return nil
+	}
+	seenNames[nameKey] = result.Row
+
+	if nameErr, err := s.checkNameAvailable(entry.ProjectName); err != nil {
+		return err
+	} else if nameErr != "" {
+		result.Status = ImportRowDuplicate
+		result.Errors = []string{nameErr}
+		return nil
+	}
+
+	if req.DryRun {
+		result.Status = ImportRowWouldCreate
+		return nil
+	}
+
+	if req.Target == ImportTargetProjects {
+		err = s.createProject(source, entry, submitReq.ExtraFields, event, result)
+	} else {
+		err = s.createSubmission(source, submitReq, entry.ExternalID, event, result)
+	}
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		// Another import created the same row concurrently, or the name was taken in the meantime
+		if found, lookupErr := s.findExisting(source, req.Target, entry.ExternalID, result); lookupErr != nil || found {
+			return lookupErr
+		}
+		result.Status = ImportRowDuplicate
+		result.Errors = []string{"DUPLICATE_PROJECT_NAME: Project with this name already exists"}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	result.Status = ImportRowCreated
+	return nil
+}
+
+// findExisting marks the row as existing when its external ID was already imported into the target
+func (s *ImportService) findExisting(source, target, externalID string, result *ImportRowResult) (bool, error) {
+	if target == ImportTargetProjects {
+		project, err := s.projectRepo.GetProjectByExternalID(source, externalID)
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		result.Status = ImportRowExisting
+		result.ProjectID = &project.ID
+		return true, nil
+	}
+
+	submission, err := s.submissionRepo.GetSubmissionByExternalID(source, externalID)
+	if err == gorm.ErrRecordNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	result.Status = ImportRowExisting
+	result.SubmissionID = submission.ID
+	result.ProjectID = submission.ApprovedProjectID
+	return true, nil
+}
+
+// validateEntry runs the same checks as POST /submissions and returns every problem found.
+// Submission windows are not enforced, since imports usually happen after an event has closed.
+func (s *ImportService) validateEntry(req *SubmitProjectRequest) (*models.Event, []string) {
+	var errs []string
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		errs = append(errs, "VALIDATION_ERROR: "+err.Error())
+	}
+	event, err := s.projectService.validateSubmissionRequest(req)
+	var fieldErr *FieldValidationError
+	if errors.As(err, &fieldErr) {
+		for _, field := range fieldErr.Fields {
+			errs = append(errs, "INVALID_EXTRA_FIELDS: "+field.Field+" "+field.Message)
+		}
+	} else if err != nil {
+		errs = append(errs, err.Error())
+	}
+	return event, errs
+}
+
+// convertExtraFields converts the text values of custom fields to the types of the event's form schema.
+// Rows with an unknown event are left alone; validation reports the event.
+func (s *ImportService) convertExtraFields(entry *importer.Entry, req *SubmitProjectRequest) error {
+	if len(entry.ExtraFields) == 0 {
+		return nil
+	}
+	req.ExtraFields = make(map[string]interface{}, len(entry.ExtraFields))
+	for name, value := range entry.ExtraFields {
+		req.ExtraFields[name] = value
+	}
+
+	event, err := s.projectService.resolveEvent(req.Event)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "INVALID_EVENT") {
+			return nil
+		}
+		return err
+	}
+	schema, err := eventFormSchema(event)
+	if err != nil || schema == nil {
+		return err
+	}
+	for name, value := range entry.ExtraFields {
+		if property := schema.Properties[name]; property != nil {
+			req.ExtraFields[name] = property.Coerce(value, entry.ListSeparator)
+		}
+	}
+	return nil
+}
+
+// checkNameAvailable returns a duplicate error message when the project name is already in use
+func (s *ImportService) checkNameAvailable(name string) (string, error) {
+	_, err := s.projectRepo.GetProjectByName(name)
+	if err == nil {
+		return "DUPLICATE_PROJECT_NAME: Project with this name already exists", nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return "", err
+	}
+
+	_, err = s.submissionRepo.GetSubmissionByProjectName(name)
+	if err == nil {
+		return "DUPLICATE_SUBMISSION: Submission with this project name already exists", nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return "", err
+	}
+	return "", nil
+}
+
+// createSubmission stores the row as a pending submission.
+// Imported submissions have no secret token; admins can issue one through the token endpoint.
+func (s *ImportService) createSubmission(source string, req *SubmitProjectRequest, externalID string, event *models.Event, result *ImportRowResult) error {
+	teamMembersJSON, err := json.Marshal(req.TeamMembers)
+	if err != nil {
+		return err
+	}
+
+	submission, err := newSubmission(req, string(teamMembersJSON))
+	if err != nil {
+		return err
+	}
+	submission.EventID = &event.ID
+	submission.ExternalSource = &source
+	submission.ExternalID = &externalID
+
+	if err := s.submissionRepo.CreateSubmission(submission); err != nil {
+		return err
+	}
+	result.SubmissionID = submission.ID
+	return nil
+}
+
+// createProject stores the row as an approved project that is visible immediately
+func (s *ImportService) createProject(source string, entry *importer.Entry, extraFields map[string]interface{}, event *models.Event, result *ImportRowResult) error {
+	publicFields, err := publicExtraFields(event, extraFields)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	project := &models.Project{
+		Name:           entry.ProjectName,
+		Logo:           entry.PhotoLink,
+		Description:    entry.Description,
+		Categories:     entry.Categories,
+		Event:          event.Name,
+		EventID:        &event.ID,
+		HowToPlay:      entry.HowToPlay,
+		PlayURL:        entry.PlayLink,
+		GithubURL:      entry.GithubLink,
+		WebsiteURL:     entry.WebsiteLink,
+		ExtraFields:    models.ExtraFields(extraFields),
+		PublicFields:   publicFields,
+		PublishedAt:    &now,
+		ExternalSource: &source,
+		ExternalID:     &entry.ExternalID,
+	}
+	for _, member := range entry.TeamMembers {
+		project.TeamMembers = append(project.TeamMembers, models.TeamMember{
+			Name:    member.Name,
+			Twitter: member.Twitter,
+		})
+	}
+
+	// Project, team members and award are inserted together so a failed row leaves nothing behind
+	err = s.awardRepo.Transaction(func(tx *gorm.DB) error {
+		if err := s.projectRepo.WithTx(tx).CreateProject(project); err != nil {
+			return err
+		}
+		if entry.Award == "" {
+			return nil
+		}
+		_, err := grantAwardByName(s.awardRepo.WithTx(tx), project, entry.Award, nil)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	result.ProjectID = &project.ID
+	return nil
+}
+
+// toSubmitProjectRequest converts a mapped row into the regular submission payload
+func toSubmitProjectRequest(entry *importer.Entry) *SubmitProjectRequest {
+	return &SubmitProjectRequest{
+		PhotoLink:       entry.PhotoLink,
+		ProjectName:     entry.ProjectName,
+		Description:     entry.Description,
+		Event:           entry.Event,
+		Categories:      entry.Categories,
+		TeamMembers:     entry.TeamMembers,
+		GithubLink:      entry.GithubLink,
+		WebsiteLink:     entry.WebsiteLink,
+		PlayLink:        entry.PlayLink,
+		HowToPlay:       entry.HowToPlay,
+		AdditionalNotes: entry.AdditionalNotes,
+	}
+}
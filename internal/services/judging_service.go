@@ -0,0 +1,645 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/utils"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+type JudgingService struct {
+	judgingRepo *repository.JudgingRepository
+	projectRepo *repository.ProjectRepository
+	eventRepo   *repository.EventRepository
+	awardRepo   *repository.AwardRepository
+}
+
+func NewJudgingService(judgingRepo *repository.JudgingRepository, projectRepo *repository.ProjectRepository, eventRepo *repository.EventRepository, awardRepo *repository.AwardRepository) *JudgingService {
+	return &JudgingService{
+		judgingRepo: judgingRepo,
+		projectRepo: projectRepo,
+		eventRepo:   eventRepo,
+		awardRepo:   awardRepo,
+	}
+}
+
+// CreateJudgeRequest represents the request to create a judge account
+type CreateJudgeRequest struct {
+	Username string   `json:"username" binding:"required,min=2"`
+	Password string   `json:"password" binding:"required,min=8"`
+	Events   []string `json:"events"`
+}
+
+// SetJudgeEventsRequest represents the request to change a judge's event assignments
+type SetJudgeEventsRequest struct {
+	Events []string `json:"events" binding:"required"`
+}
+
+// JudgeResponse represents a judge account without its credentials
+type JudgeResponse struct {
+	ID        uint      `json:"id"`
+	Username  string    `json:"username"`
+	Events    []string  `json:"events"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// CriterionInput represents one rubric criterion; criteria without an ID are created
+type CriterionInput struct {
+	ID          *uint   `json:"id,omitempty"`
+	Name        string  `json:"name" binding:"required"`
+	Description string  `json:"description"`
+	Weight      float64 `json:"weight" binding:"required,gt=0"`
+	MaxScore    int     `json:"maxScore" binding:"omitempty,min=1,max=100"`
+}
+
+// SaveRubricRequest replaces the rubric of an event
+type SaveRubricRequest struct {
+	Event    string           `json:"event" binding:"required"`
+	Criteria []CriterionInput `json:"criteria" binding:"required,min=1,dive"`
+}
+
+// ScoreInput represents a judge's score for one criterion
+type ScoreInput struct {
+	CriterionID uint     `json:"criterionId" binding:"required"`
+	Score       *float64 `json:"score" binding:"required"`
+	Comment     *string  `json:"comment,omitempty"`
+}
+
+// SubmitScoresRequest represents a judge's scores for one project
+type SubmitScoresRequest struct {
+	Scores []ScoreInput `json:"scores" binding:"required,min=1,dive"`
+}
+
+// JudgeProject is a project on a judge's worklist together with the judge's own scores
+type JudgeProject struct {
+	Project  models.Project      `json:"project"`
+	Scores   []models.JudgeScore `json:"scores"`
+	Complete bool                `json:"complete"` // Every criterion has been scored
+}
+
+// JudgeWorklist lists the projects a judge has to score for an event
+type JudgeWorklist struct {
+	Event     string                    `json:"event"`
+	Criteria  []models.JudgingCriterion `json:"criteria"`
+	Projects  []JudgeProject            `json:"projects"`
+	Completed int                       `json:"completed"`
+	Total     int                       `json:"total"`
+}
+
+// CriterionAverage is the average score of a project on one criterion, as a percentage of its max score
+type CriterionAverage struct {
+	CriterionID uint    `json:"criterionId"`
+	Name        string  `json:"name"`
+	Average     float64 `json:"average"`
+}
+
+// LeaderboardEntry is a ranked project of an event
+type LeaderboardEntry struct {
+	Rank              int                `json:"rank"`
+	ProjectID         uint               `json:"projectId"`
+	ProjectName       string             `json:"projectName"`
+	Awards            []string           `json:"awards"`
+	RawScore          float64            `json:"rawScore"`        // Mean weighted score across judges, 0-100
+	NormalizedScore   float64            `json:"normalizedScore"` // Mean of per-judge z-scores
+	JudgeCount        int                `json:"judgeCount"`
+	CriterionAverages []CriterionAverage `json:"criterionAverages"`
+
+	normalized float64
+	raw        float64
+}
+
+// Leaderboard ranks the projects of an event by their normalized judging score
+type Leaderboard struct {
+	Event            string                    `json:"event"`
+	Criteria         []models.JudgingCriterion `json:"criteria"`
+	Entries          []LeaderboardEntry        `json:"entries"`
+	UnscoredProjects int                       `json:"unscoredProjects"` // Projects no judge has fully scored yet
+}
+
+// AwardTopProjectsRequest turns the top of the leaderboard into awards of the event; awards[i] goes to rank i+1
+type AwardTopProjectsRequest struct {
+	Event  string   `json:"event" binding:"required"`
+	Awards []string `json:"awards" binding:"required,min=1,dive,required"`
+}
+
+// CreateJudge creates a judge account and assigns it to events
+func (s *JudgingService) CreateJudge(req *CreateJudgeRequest) (*JudgeResponse, error) {
+	events := utils.RemoveDuplicates(utils.RemoveEmpty(req.Events))
+	eventIDs, err := s.findEventIDs(events)
+	if err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	judge := &models.AdminUser{
+		Username: req.Username,
+		Password: string(hashedPassword),
+		Role:     "judge",
+		IsActive: true,
+	}
+
+	err = s.judgingRepo.Transaction(func(tx *gorm.DB) error {
+		txRepo := s.judgingRepo.WithTx(tx)
+		if err := txRepo.CreateJudge(judge); err != nil {
+			return err
+		}
+		return txRepo.ReplaceAssignments(judge.ID, eventIDs)
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, errors.New("USERNAME_EXISTS: Username already exists")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &JudgeResponse{
+		ID:        judge.ID,
+		Username:  judge.Username,
+		Events:    events,
+		CreatedAt: judge.CreatedAt,
+	}, nil
+}
+
+// GetJudges lists judge accounts with their event assignments
+func (s *JudgingService) GetJudges() ([]JudgeResponse, error) {
+	judges, err := s.judgingRepo.GetJudges()
+	if err != nil {
+		return nil, err
+	}
+
+	judgeIDs := make([]uint, len(judges))
+	for i, judge := range judges {
+		judgeIDs[i] = judge.ID
+	}
+	assignments, err := s.judgingRepo.GetAssignments(judgeIDs)
+	if err != nil {
+		return nil, err
+	}
+	eventsByJudge := make(map[uint][]string)
+	for _, assignment := range assignments {
+		if assignment.EventRecord != nil {
+			eventsByJudge[assignment.JudgeID] = append(eventsByJudge[assignment.JudgeID], assignment.EventRecord.Name)
+		}
+	}
+
+	response := make([]JudgeResponse, len(judges))
+	for i, judge := range judges {
+		response[i] = JudgeResponse{
+			ID:        judge.ID,
+			Username:  judge.Username,
+			Events:    eventsByJudge[judge.ID],
+			CreatedAt: judge.CreatedAt,
+		}
+	}
+	return response, nil
+}
+
+// SetJudgeEvents replaces the events a judge is assigned to
+func (s *JudgingService) SetJudgeEvents(judgeID uint, req *SetJudgeEventsRequest) (*JudgeResponse, error) {
+	judge, err := s.judgingRepo.GetJudgeByID(judgeID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := utils.RemoveDuplicates(utils.RemoveEmpty(req.Events))
+	eventIDs, err := s.findEventIDs(events)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.judgingRepo.ReplaceAssignments(judge.ID, eventIDs); err != nil {
+		return nil, err
+	}
+
+	return &JudgeResponse{
+		ID:        judge.ID,
+		Username:  judge.Username,
+		Events:    events,
+		CreatedAt: judge.CreatedAt,
+	}, nil
+}
+
+// GetRubric retrieves the judging criteria of an event
+func (s *JudgingService) GetRubric(event string) ([]models.JudgingCriterion, error) {
+	record, err := s.findEvent(event)
+	if err != nil {
+		return nil, err
+	}
+	return s.judgingRepo.GetCriteria(record.ID)
+}
+
+// SaveRubric replaces the rubric of an event. Criteria that already have scores
+// cannot be removed or have their max score changed.
+func (s *JudgingService) SaveRubric(req *SaveRubricRequest) ([]models.JudgingCriterion, error) {
+	event, err := s.findEvent(req.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.judgingRepo.Transaction(func(tx *gorm.DB) error {
+		txRepo := s.judgingRepo.WithTx(tx)
+
+		existing, err := txRepo.GetCriteria(event.ID)
+		if err != nil {
+			return err
+		}
+		existingByID := make(map[uint]models.JudgingCriterion)
+		for _, criterion := range existing {
+			existingByID[criterion.ID] = criterion
+		}
+
+		kept := make(map[uint]bool)
+		for i, input := range req.Criteria {
+			criterion := models.JudgingCriterion{EventID: event.ID}
+			if input.ID != nil {
+				current, ok := existingByID[*input.ID]
+				if !ok {
+					return fmt.Errorf("INVALID_CRITERION: Criterion %d does not belong to this event", *input.ID)
+				}
+				criterion = current
+				kept[current.ID] = true
+			}
+
+			maxScore := input.MaxScore
+			if maxScore == 0 {
+				maxScore = 10
+			}
+			if criterion.ID != 0 && criterion.MaxScore != maxScore {
+				if err := ensureUnscored(txRepo, criterion); err != nil {
+					return err
+				}
+			}
+
+			criterion.Name = input.Name
+			criterion.Description = input.Description
+			criterion.Weight = input.Weight
+			criterion.MaxScore = maxScore
+			criterion.DisplayOrder = i
+			if err := txRepo.SaveCriterion(&criterion); err != nil {
+				return err
+			}
+		}
+
+		var removed []uint
+		for _, criterion := range existing {
+			if !kept[criterion.ID] {
+				if err := ensureUnscored(txRepo, criterion); err != nil {
+					return err
+				}
+				removed = append(removed, criterion.ID)
+			}
+		}
+		return txRepo.DeleteCriteria(removed)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.judgingRepo.GetCriteria(event.ID)
+}
+
+// ensureUnscored rejects rubric changes that would invalidate recorded scores
+func ensureUnscored(repo *repository.JudgingRepository, criterion models.JudgingCriterion) error {
+	count, err := repo.CountScoresForCriteria([]uint{criterion.ID})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("RUBRIC_LOCKED: Criterion %q already has scores", criterion.Name)
+	}
+	return nil
+}
+
+// GetWorklist lists the projects of an event with the judge's own scores
+func (s *JudgingService) GetWorklist(judgeID uint, event string) (*JudgeWorklist, error) {
+	record, err := s.findEvent(event)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureAssigned(judgeID, record.ID); err != nil {
+		return nil, err
+	}
+
+	criteria, err := s.judgingRepo.GetCriteria(record.ID)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.projectRepo.GetProjectsByEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	projectIDs := make([]uint, len(projects))
+	for i, project := range projects {
+		projectIDs[i] = project.ID
+	}
+	scores, err := s.judgingRepo.GetScoresByJudge(judgeID, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+	scoresByProject := make(map[uint][]models.JudgeScore)
+	for _, score := range scores {
+		scoresByProject[score.ProjectID] = append(scoresByProject[score.ProjectID], score)
+	}
+
+	worklist := &JudgeWorklist{
+		Event:    event,
+		Criteria: criteria,
+		Projects: make([]JudgeProject, len(projects)),
+		Total:    len(projects),
+	}
+	for i, project := range projects {
+		projectScores := scoresByProject[project.ID]
+		complete := len(criteria) > 0 && len(projectScores) == len(criteria)
+		if complete {
+			worklist.Completed++
+		}
+		worklist.Projects[i] = JudgeProject{
+			Project:  project,
+			Scores:   projectScores,
+			Complete: complete,
+		}
+	}
+	return worklist, nil
+}
+
+// SubmitScores records a judge's scores for a project, replacing earlier scores for the same criteria
+func (s *JudgingService) SubmitScores(judgeID, projectID uint, req *SubmitScoresRequest) ([]models.JudgeScore, error) {
+	project, err := s.projectRepo.GetProjectByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.EventID == nil {
+		return nil, errors.New("NOT_ASSIGNED: You are not assigned to judge this event")
+	}
+	if err := s.ensureAssigned(judgeID, *project.EventID); err != nil {
+		return nil, err
+	}
+
+	criteria, err := s.judgingRepo.GetCriteria(*project.EventID)
+	if err != nil {
+		return nil, err
+	}
+	criteriaByID := make(map[uint]models.JudgingCriterion)
+	for _, criterion := range criteria {
+		criteriaByID[criterion.ID] = criterion
+	}
+
+	scores := make([]models.JudgeScore, 0, len(req.Scores))
+	seen := make(map[uint]bool)
+	for _, input := range req.Scores {
+		criterion, ok := criteriaByID[input.CriterionID]
+		if !ok {
+			return nil, fmt.Errorf("INVALID_CRITERION: Criterion %d is not part of the %s rubric", input.CriterionID, project.Event)
+		}
+		if seen[input.CriterionID] {
+			return nil, fmt.Errorf("INVALID_SCORE: Criterion %d is scored more than once", input.CriterionID)
+		}
+		seen[input.CriterionID] = true
+
+		if *input.Score < 0 || *input.Score > float64(criterion.MaxScore) {
+			return nil, fmt.Errorf("INVALID_SCORE: Score for %q must be between 0 and %d", criterion.Name, criterion.MaxScore)
+		}
+		scores = append(scores, models.JudgeScore{
+			JudgeID:     judgeID,
+			ProjectID:   project.ID,
+			CriterionID: criterion.ID,
+			Score:       *input.Score,
+			Comment:     input.Comment,
+		})
+	}
+
+	if err := s.judgingRepo.UpsertScores(scores); err != nil {
+		return nil, err
+	}
+	return s.judgingRepo.GetScoresByJudge(judgeID, []uint{project.ID})
+}
+
+// ensureAssigned rejects judges that are not assigned to the event
+func (s *JudgingService) ensureAssigned(judgeID, eventID uint) error {
+	assigned, err := s.judgingRepo.IsAssigned(judgeID, eventID)
+	if err != nil {
+		return err
+	}
+	if !assigned {
+		return errors.New("NOT_ASSIGNED: You are not assigned to judge this event")
+	}
+	return nil
+}
+
+// GetLeaderboard ranks the projects of an event.
+// Each judge's weighted totals are converted to z-scores before averaging, so a judge who
+// scores everyone harshly or generously does not skew the ranking. Only projects a judge
+// scored on every criterion count towards that judge's statistics.
+func (s *JudgingService) GetLeaderboard(event string) (*Leaderboard, error) {
+	record, err := s.findEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	criteria, err := s.judgingRepo.GetCriteria(record.ID)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := s.projectRepo.GetProjectsByEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	criterionIDs := make([]uint, len(criteria))
+	criteriaByID := make(map[uint]models.JudgingCriterion)
+	totalWeight := 0.0
+	for i, criterion := range criteria {
+		criterionIDs[i] = criterion.ID
+		criteriaByID[criterion.ID] = criterion
+		totalWeight += criterion.Weight
+	}
+	scores, err := s.judgingRepo.GetScoresForCriteria(criterionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Group scores by judge and project
+	type judgeProject struct{ judgeID, projectID uint }
+	sheets := make(map[judgeProject]map[uint]float64)
+	for _, score := range scores {
+		key := judgeProject{score.JudgeID, score.ProjectID}
+		if sheets[key] == nil {
+			sheets[key] = make(map[uint]float64)
+		}
+		sheets[key][score.CriterionID] = score.Score
+	}
+
+	// Weighted total (0-100) of every complete score sheet
+	totalsByJudge := make(map[uint]map[uint]float64)
+	for key, sheet := range sheets {
+		if len(criteria) == 0 || len(sheet) != len(criteria) {
+			continue
+		}
+		total := 0.0
+		for criterionID, score := range sheet {
+			criterion := criteriaByID[criterionID]
+			total += criterion.Weight * score / float64(criterion.MaxScore)
+		}
+		if totalsByJudge[key.judgeID] == nil {
+			totalsByJudge[key.judgeID] = make(map[uint]float64)
+		}
+		totalsByJudge[key.judgeID][key.projectID] = total / totalWeight * 100
+	}
+
+	// Convert each judge's totals into z-scores
+	type projectAccumulator struct {
+		rawSum, zSum float64
+		judges       int
+		criterionSum map[uint]float64
+	}
+	accumulators := make(map[uint]*projectAccumulator)
+	for judgeID, totals := range totalsByJudge {
+		mean, stddev := meanStddev(totals)
+		for projectID, total := range totals {
+			acc := accumulators[projectID]
+			if acc == nil {
+				acc = &projectAccumulator{criterionSum: make(map[uint]float64)}
+				accumulators[projectID] = acc
+			}
+			acc.rawSum += total
+			if stddev > 0 {
+				acc.zSum += (total - mean) / stddev
+			}
+			acc.judges++
+			for criterionID, score := range sheets[judgeProject{judgeID, projectID}] {
+				acc.criterionSum[criterionID] += score / float64(criteriaByID[criterionID].MaxScore) * 100
+			}
+		}
+	}
+
+	leaderboard := &Leaderboard{
+		Event:    event,
+		Criteria: criteria,
+		Entries:  []LeaderboardEntry{},
+	}
+	for _, project := range projects {
+		acc := accumulators[project.ID]
+		if acc == nil {
+			leaderboard.UnscoredProjects++
+			continue
+		}
+
+		judges := float64(acc.judges)
+		entry := LeaderboardEntry{
+			ProjectID:         project.ID,
+			ProjectName:       project.Name,
+			Awards:            awardNames(&project),
+			JudgeCount:        acc.judges,
+			CriterionAverages: make([]CriterionAverage, len(criteria)),
+			raw:               acc.rawSum / judges,
+			normalized:        acc.zSum / judges,
+		}
+		entry.RawScore = roundMetric(entry.raw)
+		entry.NormalizedScore = roundMetric(entry.normalized)
+		for i, criterion := range criteria {
+			entry.CriterionAverages[i] = CriterionAverage{
+				CriterionID: criterion.ID,
+				Name:        criterion.Name,
+				Average:     roundMetric(acc.criterionSum[criterion.ID] / judges),
+			}
+		}
+		leaderboard.Entries = append(leaderboard.Entries, entry)
+	}
+
+	sort.SliceStable(leaderboard.Entries, func(i, j int) bool {
+		a, b := leaderboard.Entries[i], leaderboard.Entries[j]
+		if a.normalized != b.normalized {
+			return a.normalized > b.normalized
+		}
+		return a.raw > b.raw
+	})
+	for i := range leaderboard.Entries {
+		leaderboard.Entries[i].Rank = i + 1
+	}
+
+	return leaderboard, nil
+}
+
+// AwardTopProjects grants the given awards to the top-ranked projects of an event in one step
+func (s *JudgingService) AwardTopProjects(req *AwardTopProjectsRequest) ([]LeaderboardEntry, error) {
+	leaderboard, err := s.GetLeaderboard(req.Event)
+	if err != nil {
+		return nil, err
+	}
+	if len(leaderboard.Entries) < len(req.Awards) {
+		return nil, fmt.Errorf("NOT_ENOUGH_SCORED_PROJECTS: Only %d projects have been scored", len(leaderboard.Entries))
+	}
+
+	winners := leaderboard.Entries[:len(req.Awards)]
+	err = s.awardRepo.Transaction(func(tx *gorm.DB) error {
+		awardRepo := s.awardRepo.WithTx(tx)
+		for i := range winners {
+			project, err := s.projectRepo.WithTx(tx).GetProjectByID(winners[i].ProjectID)
+			if err != nil {
+				return err
+			}
+			rank := i + 1
+			if _, err := grantAwardByName(awardRepo, project, req.Awards[i], &rank); err != nil {
+				return err
+			}
+			winners[i].Awards = utils.RemoveDuplicates(append(winners[i].Awards, req.Awards[i]))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return winners, nil
+}
+
+// findEvent retrieves an event by name
+func (s *JudgingService) findEvent(name string) (*models.Event, error) {
+	event, err := s.eventRepo.GetEventByName(name)
+	if err == gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("INVALID_EVENT: Invalid event provided: %s", name)
+	}
+	return event, err
+}
+
+// findEventIDs resolves event names to IDs, so judges are only assigned to known events
+func (s *JudgingService) findEventIDs(names []string) ([]uint, error) {
+	ids := make([]uint, len(names))
+	for i, name := range names {
+		event, err := s.findEvent(name)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = event.ID
+	}
+	return ids, nil
+}
+
+// meanStddev returns the mean and population standard deviation of the values
+func meanStddev(values map[uint]float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+	mean := sum / float64(len(values))
+
+	variance := 0.0
+	for _, value := range values {
+		variance += (value - mean) * (value - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(values)))
+}
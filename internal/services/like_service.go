@@ -0,0 +1,205 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+type LikeService struct {
+	likeRepo      *repository.LikeRepository
+	projectRepo   *repository.ProjectRepository
+	secret        string
+	maxLikesPerIP int
+}
+
+func NewLikeService(likeRepo *repository.LikeRepository, projectRepo *repository.ProjectRepository, secret string, maxLikesPerIP int) *LikeService {
+	return &LikeService{
+		likeRepo:      likeRepo,
+		projectRepo:   projectRepo,
+		secret:        secret,
+		maxLikesPerIP: maxLikesPerIP,
+	}
+}
+
+// VoterInput is what a request reveals about who is voting
+type VoterInput struct {
+	Account     string // "role:username" of an authenticated caller
+	DeviceToken string // Signed anonymous token issued by this server
+	Fingerprint string // Client-computed browser fingerprint
+	IP          string
+}
+
+// Voter is the resolved identity likes are recorded under
+type Voter struct {
+	Key    string // Empty when the caller cannot be identified yet
+	Type   string // account, device or fingerprint
+	IPHash string
+}
+
+// LikeResponse reports a project's likes after a like or unlike
+type LikeResponse struct {
+	Likes       int    `json:"likes"`
+	LikedByMe   bool   `json:"likedByMe"`
+	Changed     bool   `json:"changed"`               // False when the voter had already liked (or not liked) the project
+	DeviceToken string `json:"deviceToken,omitempty"` // Issued to anonymous voters; send back as X-Device-Token
+}
+
+// ResolveVoter identifies the caller, preferring an account over a device token over IP plus fingerprint.
+// A malformed or forged device token is an error rather than silently falling back.
+func (s *LikeService) ResolveVoter(in VoterInput) (Voter, error) {
+	voter := Voter{IPHash: utils.KeyedHash(s.secret, in.IP)}
+	switch {
+	case in.Account != "":
+		voter.Key = "account:" + in.Account
+		voter.Type = "account"
+	case in.DeviceToken != "":
+		id, ok := utils.ParseDeviceToken(s.secret, in.DeviceToken)
+		if !ok {
+			return voter, errors.New("INVALID_DEVICE_TOKEN: Device token is invalid")
+		}
+		voter.Key = "device:" + id
+		voter.Type = "device"
+	case strings.TrimSpace(in.Fingerprint) != "":
+		voter.Key = "fingerprint:" + utils.KeyedHash(s.secret, in.IP+"|"+strings.TrimSpace(in.Fingerprint))
+		voter.Type = "fingerprint"
+	}
+	return voter, nil
+}
+
+// LikeProject records the voter's like on a published project; liking twice has no effect.
+// Callers that cannot be identified are issued a device token that the like is recorded under.
+func (s *LikeService) LikeProject(projectID uint, in VoterInput) (*LikeResponse, error) {
+	voter, err := s.ResolveVoter(in)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.projectRepo.GetPublishedProjectByID(projectID); err != nil {
+		return nil, err
+	}
+
+	response := &LikeResponse{}
+	if voter.Key == "" {
+		token, err := utils.GenerateDeviceToken(s.secret)
+		if err != nil {
+			return nil, err
+		}
+		id, _ := utils.ParseDeviceToken(s.secret, token)
+		voter.Key = "device:" + id
+		voter.Type = "device"
+		response.DeviceToken = token
+	}
+
+	like := &models.ProjectLike{
+		ProjectID: projectID,
+		VoterKey:  voter.Key,
+		VoterType: voter.Type,
+		IPHash:    voter.IPHash,
+	}
+	var added bool
+	if voter.Type == "account" {
+		added, err = s.likeRepo.AddLike(like)
+	} else {
+		added, err = s.addAnonymousLike(like)
+	}
+	if err != nil {
+		return nil, err
+	}
+	response.Changed = added
+	response.LikedByMe = true
+	return s.withLikes(projectID, response)
+}
+
+// addAnonymousLike records a like from a voter without an account. New anonymous identities are cheap, so each
+// IP address only gets so many anonymous likes per project; the count and the insert run under a lock on the
+// project and IP so parallel requests cannot overshoot the limit
+func (s *LikeService) addAnonymousLike(like *models.ProjectLike) (bool, error) {
+	added := false
+	err := s.likeRepo.Transaction(func(tx *gorm.DB) error {
+		likeRepo := s.likeRepo.WithTx(tx)
+		if err := likeRepo.LockIPLikes(like.ProjectID, like.IPHash); err != nil {
+			return err
+		}
+
+		count, err := likeRepo.CountLikesFromIP(like.ProjectID, like.IPHash)
+		if err != nil {
+			return err
+		}
+		if count >= int64(s.maxLikesPerIP) {
+			liked, err := likeRepo.GetLikedProjectIDs(like.VoterKey, []uint{like.ProjectID})
+			if err != nil {
+				return err
+			}
+			if !liked[like.ProjectID] {
+				return errors.New("LIKE_LIMIT_REACHED: Too many likes for this project from your network")
+			}
+		}
+
+		added, err = likeRepo.AddLike(like)
+		return err
+	})
+	return added, err
+}
+
+// UnlikeProject removes the voter's like from a published project; unliking twice has no effect
+func (s *LikeService) UnlikeProject(projectID uint, in VoterInput) (*LikeResponse, error) {
+	voter, err := s.ResolveVoter(in)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.projectRepo.GetPublishedProjectByID(projectID); err != nil {
+		return nil, err
+	}
+
+	response := &LikeResponse{}
+	if voter.Key != "" {
+		removed, err := s.likeRepo.RemoveLike(projectID, voter.Key)
+		if err != nil {
+			return nil, err
+		}
+		response.Changed = removed
+	}
+	return s.withLikes(projectID, response)
+}
+
+// withLikes fills in the project's current likes counter
+func (s *LikeService) withLikes(projectID uint, response *LikeResponse) (*LikeResponse, error) {
+	likes, err := s.likeRepo.GetLikeCount(projectID)
+	if err != nil {
+		return nil, err
+	}
+	response.Likes = likes
+	return response, nil
+}
+
+// MarkLiked sets LikedByMe on the projects the caller has liked. Unidentified callers and
+// invalid device tokens simply see nothing liked.
+func (s *LikeService) MarkLiked(projects []models.Project, in VoterInput) error {
+	voter, err := s.ResolveVoter(in)
+	if err != nil || voter.Key == "" {
+		return nil
+	}
+
+	ids := make([]uint, len(projects))
+	for i, project := range projects {
+		ids[i] = project.ID
+	}
+	liked, err := s.likeRepo.GetLikedProjectIDs(voter.Key, ids)
+	if err != nil {
+		return err
+	}
+	for i := range projects {
+		projects[i].LikedByMe = liked[projects[i].ID]
+	}
+	return nil
+}
+
+// ReconcileLikeCounts recomputes every project's likes counter and returns how many were corrected
+func (s *LikeService) ReconcileLikeCounts() (int64, error) {
+	return s.likeRepo.ReconcileLikeCounts()
+}
@@ -0,0 +1,373 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"monad-devhub-be/internal/media"
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/storage"
+
+	"gorm.io/gorm"
+)
+
+type MediaService struct {
+	storage       storage.Storage
+	mediaRepo     *repository.MediaRepository
+	projectRepo   *repository.ProjectRepository
+	maxImageBytes int64
+	maxVideoBytes int64
+	uploadTTL     time.Duration
+}
+
+func NewMediaService(store storage.Storage, mediaRepo *repository.MediaRepository, projectRepo *repository.ProjectRepository, maxImageBytes, maxVideoBytes int64, uploadTTL time.Duration) *MediaService {
+	return &MediaService{
+		storage:       store,
+		mediaRepo:     mediaRepo,
+		projectRepo:   projectRepo,
+		maxImageBytes: maxImageBytes,
+		maxVideoBytes: maxVideoBytes,
+		uploadTTL:     uploadTTL,
+	}
+}
+
+// UploadedFile describes a stored upload and its thumbnail
+type UploadedFile struct {
+	Kind         string `json:"kind"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnailUrl,omitempty"`
+	ContentType  string `json:"contentType"`
+	Size         int64  `json:"size"`
+	Width        int    `json:"width,omitempty"`
+	Height       int    `json:"height,omitempty"`
+
+	key          string
+	thumbnailKey string
+}
+
+// ReorderMediaRequest lists every gallery item of a project in its new order
+type ReorderMediaRequest struct {
+	MediaIDs []uint `json:"mediaIds" binding:"required"`
+}
+
+// MaxUploadBytes returns the largest upload any endpoint accepts
+func (s *MediaService) MaxUploadBytes() int64 {
+	return max(s.maxImageBytes, s.maxVideoBytes)
+}
+
+// UploadImage stores an image for use in a submission, e.g. as its photoLink.
+// The upload is recorded so it can be deleted if no submission uses it; see RunUploadCleanup
+func (s *MediaService) UploadImage(ctx context.Context, file io.ReadSeeker, size int64) (*UploadedFile, error) {
+	upload, err := s.store(ctx, "uploads", file, size, false)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &models.Upload{URL: upload.URL, StorageKey: upload.key, ThumbnailKey: upload.thumbnailKey}
+	if err := s.mediaRepo.CreateUpload(record); err != nil {
+		s.removeFiles(upload.key, upload.thumbnailKey)
+		return nil, err
+	}
+	return upload, nil
+}
+
+// RunUploadCleanup periodically deletes uploads that nothing references once the upload TTL has passed,
+// until the process exits
+func (s *MediaService) RunUploadCleanup(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := s.cleanupUploads(time.Now().Add(-s.uploadTTL))
+		if err != nil {
+			log.Printf("Failed to clean up unused uploads: %v", err)
+		} else if deleted > 0 {
+			log.Printf("Deleted %d unused uploads", deleted)
+		}
+		<-ticker.C
+	}
+}
+
+// cleanupUploads deletes the unclaimed uploads created before the given time that nothing references
+// and claims the rest. It returns how many uploads were deleted
+func (s *MediaService) cleanupUploads(before time.Time) (int, error) {
+	deleted := 0
+	for {
+		uploads, err := s.mediaRepo.GetExpiredUploads(before, 100)
+		if err != nil || len(uploads) == 0 {
+			return deleted, err
+		}
+
+		for _, upload := range uploads {
+			referenced, err := s.mediaRepo.IsUploadReferenced(upload.URL)
+			if err != nil {
+				return deleted, err
+			}
+			if referenced {
+				if err := s.mediaRepo.ClaimUpload(upload.ID); err != nil {
+					return deleted, err
+				}
+				continue
+			}
+
+			if err := s.mediaRepo.DeleteUpload(upload.ID); err != nil {
+				return deleted, err
+			}
+			s.removeFiles(upload.StorageKey, upload.ThumbnailKey)
+			deleted++
+		}
+	}
+}
+
+// SetProjectLogo uploads a new project logo and removes the previous one if it was uploaded too
+func (s *MediaService) SetProjectLogo(ctx context.Context, projectID uint, file io.ReadSeeker, size int64) (*UploadedFile, error) {
+	project, err := s.projectRepo.GetProjectByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := s.store(ctx, "logos", file, size, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.projectRepo.UpdateLogo(project.ID, upload.URL, upload.ThumbnailURL); err != nil {
+		s.removeFiles(upload.key, upload.thumbnailKey)
+		return nil, err
+	}
+
+	s.removeURLs(project.Logo, project.LogoThumbnail)
+	return upload, nil
+}
+
+// SetTeamMemberImage uploads a team member's photo and removes the previous one if it was uploaded too
+func (s *MediaService) SetTeamMemberImage(ctx context.Context, projectID, memberID uint, file io.ReadSeeker, size int64) (*UploadedFile, error) {
+	project, err := s.projectRepo.GetProjectByID(projectID)
+	if err != nil {
+		return nil, err
+	}
+	var member *models.TeamMember
+	for i := range project.TeamMembers {
+		if project.TeamMembers[i].ID == memberID {
+			member = &project.TeamMembers[i]
+		}
+	}
+	if member == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	upload, err := s.store(ctx, "team", file, size, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.projectRepo.UpdateTeamMemberImage(member.ID, upload.URL, upload.ThumbnailURL); err != nil {
+		s.removeFiles(upload.key, upload.thumbnailKey)
+		return nil, err
+	}
+
+	s.removeURLs(member.Image, member.ImageThumbnail)
+	return upload, nil
+}
+
+// AddProjectMedia uploads a screenshot or video and appends it to the project's gallery
+func (s *MediaService) AddProjectMedia(ctx context.Context, projectID uint, file io.ReadSeeker, size int64, caption string) (*models.ProjectMedia, error) {
+	if _, err := s.projectRepo.GetProjectByID(projectID); err != nil {
+		return nil, err
+	}
+
+	upload, err := s.store(ctx, "media", file, size, true)
+	if err != nil {
+		return nil, err
+	}
+
+	item := &models.ProjectMedia{
+		ProjectID:    projectID,
+		Kind:         upload.Kind,
+		URL:          upload.URL,
+		ThumbnailURL: upload.ThumbnailURL,
+		ContentType:  upload.ContentType,
+		Size:         upload.Size,
+		Width:        upload.Width,
+		Height:       upload.Height,
+		Caption:      strings.TrimSpace(caption),
+		StorageKey:   upload.key,
+		ThumbnailKey: upload.thumbnailKey,
+	}
+	if err := s.mediaRepo.CreateProjectMedia(item); err != nil {
+		s.removeFiles(upload.key, upload.thumbnailKey)
+		return nil, err
+	}
+	return item, nil
+}
+
+// ReorderProjectMedia rearranges a project's gallery; every item must be listed exactly once
+func (s *MediaService) ReorderProjectMedia(projectID uint, req *ReorderMediaRequest) ([]models.ProjectMedia, error) {
+	if _, err := s.projectRepo.GetProjectByID(projectID); err != nil {
+		return nil, err
+	}
+	items, err := s.mediaRepo.GetProjectMedia(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := make(map[uint]bool, len(items))
+	for _, item := range items {
+		remaining[item.ID] = true
+	}
+	for _, id := range req.MediaIDs {
+		if !remaining[id] {
+			return nil, fmt.Errorf("INVALID_MEDIA_ORDER: Media %d is not in the gallery or listed twice", id)
+		}
+		delete(remaining, id)
+	}
+	if len(remaining) > 0 {
+		return nil, errors.New("INVALID_MEDIA_ORDER: Every gallery item must be listed")
+	}
+
+	if err := s.mediaRepo.UpdateDisplayOrder(projectID, req.MediaIDs); err != nil {
+		return nil, err
+	}
+	return s.mediaRepo.GetProjectMedia(projectID)
+}
+
+// DeleteProjectMedia removes an item from a project's gallery together with its files
+func (s *MediaService) DeleteProjectMedia(projectID, mediaID uint) error {
+	item, err := s.mediaRepo.GetProjectMediaByID(projectID, mediaID)
+	if err != nil {
+		return err
+	}
+	if err := s.mediaRepo.DeleteProjectMedia(item.ID); err != nil {
+		return err
+	}
+	s.removeFiles(item.StorageKey, item.ThumbnailKey)
+	return nil
+}
+
+// RemoveProjectFiles deletes the stored logo, team photos and gallery files of a purged project
+func (s *MediaService) RemoveProjectFiles(project *models.Project) {
+	s.removeURLs(project.Logo, project.LogoThumbnail)
+	for _, member := range project.TeamMembers {
+		s.removeURLs(member.Image, member.ImageThumbnail)
+	}
+	for _, item := range project.Media {
+		s.removeFiles(item.StorageKey, item.ThumbnailKey)
+	}
+}
+
+// store validates an upload by its content, enforces the size limit of its kind and stores it
+// under prefix/yyyy/mm/ with a random name. Images also get a thumbnail.
+func (s *MediaService) store(ctx context.Context, prefix string, file io.ReadSeeker, size int64, allowVideo bool) (*UploadedFile, error) {
+	header := make([]byte, 512)
+	n, err := io.ReadFull(file, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	contentType, kind, ok := media.Detect(header[:n])
+	if !ok || (kind == media.KindVideo && !allowVideo) {
+		if allowVideo {
+			return nil, errors.New("UNSUPPORTED_MEDIA_TYPE: Only PNG, JPEG and GIF images or MP4 and WebM videos are accepted")
+		}
+		return nil, errors.New("UNSUPPORTED_MEDIA_TYPE: Only PNG, JPEG and GIF images are accepted")
+	}
+
+	limit := s.maxImageBytes
+	if kind == media.KindVideo {
+		limit = s.maxVideoBytes
+	}
+	if size > limit {
+		return nil, fmt.Errorf("FILE_TOO_LARGE: %s uploads are limited to %d MB", kind, limit>>20)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	name, err := randomName()
+	if err != nil {
+		return nil, err
+	}
+	base := path.Join(prefix, time.Now().UTC().Format("2006/01"), name)
+	upload := &UploadedFile{
+		Kind:        kind,
+		ContentType: contentType,
+		Size:        size,
+		key:         base + media.Extension(contentType),
+	}
+
+	if kind == media.KindVideo {
+		if err := s.storage.Put(ctx, upload.key, file, size, contentType); err != nil {
+			return nil, err
+		}
+		upload.URL = s.storage.URL(upload.key)
+		return upload, nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != size {
+		return nil, errors.New("INVALID_IMAGE: Upload size does not match its content")
+	}
+	processed, err := media.ProcessImage(data)
+	if errors.Is(err, media.ErrInvalidImage) {
+		return nil, errors.New("INVALID_IMAGE: Image could not be decoded or is too large")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnail := processed.Thumbnail
+	upload.thumbnailKey = base + "_thumb" + media.Extension(thumbnail.ContentType)
+	if err := s.storage.Put(ctx, upload.key, bytes.NewReader(data), size, contentType); err != nil {
+		return nil, err
+	}
+	if err := s.storage.Put(ctx, upload.thumbnailKey, bytes.NewReader(thumbnail.Data), int64(len(thumbnail.Data)), thumbnail.ContentType); err != nil {
+		s.removeFiles(upload.key)
+		return nil, err
+	}
+
+	upload.URL = s.storage.URL(upload.key)
+	upload.ThumbnailURL = s.storage.URL(upload.thumbnailKey)
+	upload.Width = processed.Width
+	upload.Height = processed.Height
+	return upload, nil
+}
+
+// removeURLs deletes the files behind URLs that point into our storage; external URLs are left alone
+func (s *MediaService) removeURLs(urls ...string) {
+	for _, url := range urls {
+		if key, ok := storage.KeyFromURL(s.storage, url); ok {
+			s.removeFiles(key)
+		}
+	}
+}
+
+// removeFiles deletes stored files; failures only leave orphans behind, so they are logged
+func (s *MediaService) removeFiles(keys ...string) {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if err := s.storage.Delete(context.Background(), key); err != nil {
+			log.Printf("Failed to delete stored file %s: %v", key, err)
+		}
+	}
+}
+
+// randomName returns an unguessable file name
+func randomName() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
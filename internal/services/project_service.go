@@ -3,47 +3,74 @@ package services
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"html"
 	"math"
+	"reflect"
+	"strconv"
+	"strings"
 	"time"
 
 	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/pagination"
 	"monad-devhub-be/internal/repository"
 	"monad-devhub-be/internal/utils"
 
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
 type ProjectService struct {
 	projectRepo    *repository.ProjectRepository
 	submissionRepo *repository.SubmissionRepository
+	eventRepo      *repository.EventRepository
+	categoryRepo   *repository.CategoryRepository
+	purgeHooks     []PurgeHook
 }
 
-func NewProjectService(projectRepo *repository.ProjectRepository, submissionRepo *repository.SubmissionRepository) *ProjectService {
+// PurgeHook is called after a project has been permanently deleted, e.g. to remove its stored files
+type PurgeHook func(project *models.Project)
+
+func NewProjectService(projectRepo *repository.ProjectRepository, submissionRepo *repository.SubmissionRepository, eventRepo *repository.EventRepository, categoryRepo *repository.CategoryRepository) *ProjectService {
 	return &ProjectService{
 		projectRepo:    projectRepo,
 		submissionRepo: submissionRepo,
+		eventRepo:      eventRepo,
+		categoryRepo:   categoryRepo,
 	}
 }
 
+// WithTx returns a copy of the service whose project and submission queries run in tx
+func (s *ProjectService) WithTx(tx *gorm.DB) *ProjectService {
+	txService := *s
+	txService.projectRepo = s.projectRepo.WithTx(tx)
+	txService.submissionRepo = s.submissionRepo.WithTx(tx)
+	return &txService
+}
+
 // SubmitProjectRequest represents the request payload for project submission
 type SubmitProjectRequest struct {
-	PhotoLink       string                   `json:"photoLink" binding:"required"`
-	ProjectName     string                   `json:"projectName" binding:"required"`
-	Description     string                   `json:"description" binding:"required"`
-	Event           string                   `json:"event" binding:"required"`
-	Categories      []string                 `json:"categories" binding:"required,min=1"`
-	TeamMembers     []models.TeamMemberInput `json:"teamMembers" binding:"required,min=1"`
-	GithubLink      *string                  `json:"githubLink,omitempty"`
-	WebsiteLink     *string                  `json:"websiteLink,omitempty"`
-	PlayLink        string                   `json:"playLink" binding:"required"`
-	HowToPlay       string                   `json:"howToPlay" binding:"required"`
-	AdditionalNotes *string                  `json:"additionalNotes,omitempty"`
+	PhotoLink         string                   `json:"photoLink" binding:"required"`
+	ProjectName       string                   `json:"projectName" binding:"required"`
+	Description       string                   `json:"description" binding:"required"`
+	Event             string                   `json:"event" binding:"required"`
+	Categories        []string                 `json:"categories" binding:"required,min=1"`
+	TeamMembers       []models.TeamMemberInput `json:"teamMembers" binding:"required,min=1"`
+	GithubLink        *string                  `json:"githubLink,omitempty"`
+	WebsiteLink       *string                  `json:"websiteLink,omitempty"`
+	PlayLink          string                   `json:"playLink" binding:"required"`
+	HowToPlay         string                   `json:"howToPlay" binding:"required"`
+	AdditionalNotes   *string                  `json:"additionalNotes,omitempty"`
+	ExtraFields       map[string]interface{}   `json:"extraFields,omitempty"`       // Values for the event's form schema
+	ContractAddresses []string                 `json:"contractAddresses,omitempty"` // Deployed contracts, verified by admins after approval
+	OwnerAddress      string                   `json:"-"`                           // Address of the signed-in builder submitting
 }
 
 // SubmitProjectResponse represents the response for project submission
 type SubmitProjectResponse struct {
 	Success             bool     `json:"success"`
 	SubmissionID        string   `json:"submissionId"`
+	SubmissionToken     string   `json:"submissionToken"` // Secret shown once; unlocks the full status view
 	Message             string   `json:"message"`
 	EstimatedReviewTime string   `json:"estimatedReviewTime"`
 	NextSteps           []string `json:"nextSteps"`
@@ -59,6 +86,7 @@ type GetProjectsRequest struct {
 	Search    string   `form:"search"`
 	SortBy    string   `form:"sortBy"`
 	SortOrder string   `form:"sortOrder"`
+	Cursor    string   `form:"cursor"`
 }
 
 // GetProjectsResponse represents the response for getting projects
@@ -69,10 +97,12 @@ type GetProjectsResponse struct {
 }
 
 type PaginationInfo struct {
-	Page       int `json:"page"`
-	Limit      int `json:"limit"`
-	Total      int `json:"total"`
-	TotalPages int `json:"totalPages"`
+	Page       int    `json:"page"`
+	Limit      int    `json:"limit"`
+	Total      int    `json:"total"`
+	TotalPages int    `json:"totalPages"`
+	NextCursor string `json:"nextCursor,omitempty"` // Opaque position of the next page, for lists with keyset pagination
+	PrevCursor string `json:"prevCursor,omitempty"`
 }
 
 type FilterInfo struct {
@@ -81,15 +111,65 @@ type FilterInfo struct {
 	Awards     []string `json:"awards"`
 }
 
+// CreateProjectRequest represents the payload for creating a project directly, without a submission
+type CreateProjectRequest struct {
+	Name        string                   `json:"name" binding:"required"`
+	Description string                   `json:"description" binding:"required"`
+	Logo        string                   `json:"logo" binding:"required"`
+	Event       string                   `json:"event" binding:"required"` // Event name or slug
+	Categories  []string                 `json:"categories" binding:"required,min=1"`
+	HowToPlay   string                   `json:"howToPlay" binding:"required"`
+	PlayURL     string                   `json:"playUrl" binding:"required"`
+	GithubURL   *string                  `json:"github,omitempty"`
+	WebsiteURL  *string                  `json:"website,omitempty"`
+	ExtraFields map[string]interface{}   `json:"extraFields,omitempty"`
+	Team        []models.TeamMemberInput `json:"team" binding:"required,min=1"`
+	PublishAt   *time.Time               `json:"publishAt,omitempty"` // Scheduled reveal; omitted or past publishes right away
+}
+
+// UpdateProjectRequest represents the payload for an admin edit of a project; omitted fields stay as they are
+type UpdateProjectRequest struct {
+	Name        *string                `json:"name"`
+	Description *string                `json:"description"`
+	Logo        *string                `json:"logo"`
+	Event       *string                `json:"event"` // Event name or slug
+	Categories  []string               `json:"categories"`
+	HowToPlay   *string                `json:"howToPlay"`
+	PlayURL     *string                `json:"playUrl"`
+	GithubURL   *string                `json:"github"`      // Empty removes the link
+	WebsiteURL  *string                `json:"website"`     // Empty removes the link
+	ExtraFields map[string]interface{} `json:"extraFields"` // Replaces all extra field values
+}
+
+// FeatureProjectRequest represents the payload for featuring a project or taking it out of the carousel
+type FeatureProjectRequest struct {
+	Featured bool       `json:"featured"`
+	Order    int        `json:"order"` // Position in the carousel, lowest first
+	From     *time.Time `json:"from"`  // Start of the featured window; null starts it right away
+	Until    *time.Time `json:"until"` // End of the featured window; null keeps it open
+}
+
+// DeletedProject is a soft-deleted project that can still be restored or purged
+type DeletedProject struct {
+	models.Project
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
 // SubmitProject handles project submission with validation and submission ID generation
 func (s *ProjectService) SubmitProject(req *SubmitProjectRequest) (*SubmitProjectResponse, error) {
 	// Validate request
-	if err := s.validateSubmissionRequest(req); err != nil {
+	event, err := s.validateSubmissionRequest(req)
+	if err != nil {
 		return nil, err
 	}
 
+	// Only accept submissions inside the event's submission window
+	if !acceptsSubmissions(event, time.Now()) {
+		return nil, errors.New("SUBMISSIONS_CLOSED: Submissions for this event are not open")
+	}
+
 	// Check for duplicate project name
-	_, err := s.projectRepo.GetProjectByName(req.ProjectName)
+	_, err = s.projectRepo.GetProjectByName(req.ProjectName)
 	if err == nil {
 		return nil, errors.New("DUPLICATE_PROJECT_NAME: Project with this name already exists")
 	}
@@ -106,8 +186,11 @@ func (s *ProjectService) SubmitProject(req *SubmitProjectRequest) (*SubmitProjec
 		return nil, err
 	}
 
-	// Generate unique submission ID
-	submissionID := utils.GenerateSubmissionID()
+	// Generate the secret token that lets the submitter see private fields
+	submissionToken, submissionTokenHash, err := utils.GenerateSecretToken()
+	if err != nil {
+		return nil, err
+	}
 
 	// Convert team members to JSON
 	teamMembersJSON, err := json.Marshal(req.TeamMembers)
@@ -115,42 +198,67 @@ func (s *ProjectService) SubmitProject(req *SubmitProjectRequest) (*SubmitProjec
 		return nil, err
 	}
 
-	// Create submission
-	submission := &models.Submission{
-		ID:              submissionID,
-		ProjectName:     req.ProjectName,
-		Description:     req.Description,
-		PhotoLink:       req.PhotoLink,
-		Event:           req.Event,
-		Categories:      req.Categories,
-		TeamMembers:     string(teamMembersJSON),
-		GithubLink:      req.GithubLink,
-		WebsiteLink:     req.WebsiteLink,
-		PlayLink:        req.PlayLink,
-		HowToPlay:       req.HowToPlay,
-		AdditionalNotes: req.AdditionalNotes,
-		Status:          "pending",
-		SubmittedAt:     time.Now(),
+	// Create submission with a freshly generated submission ID
+	submission, err := newSubmission(req, string(teamMembersJSON))
+	if err != nil {
+		return nil, err
+	}
+	submission.EventID = &event.ID
+	submission.AccessTokenHash = submissionTokenHash
+	if req.OwnerAddress != "" {
+		submission.OwnerAddress = &req.OwnerAddress
 	}
 
 	if err := s.submissionRepo.CreateSubmission(submission); err != nil {
 		return nil, err
 	}
 
+	// The repository may have replaced a colliding ID
+	submissionID := submission.ID
+
 	// Return success response
 	return &SubmitProjectResponse{
 		Success:             true,
 		SubmissionID:        submissionID,
+		SubmissionToken:     submissionToken,
 		Message:             "Your project has been submitted successfully!",
 		EstimatedReviewTime: "2-3 business days",
 		NextSteps: []string{
 			"We'll review your submission within 2-3 business days",
 			"You'll receive an email update when review is complete",
 			"Use submission ID " + submissionID + " to check status anytime",
+			"Keep your submission token private; send it as X-Submission-Token to see reviewer feedback",
 		},
 	}, nil
 }
 
+// newSubmission builds a pending submission from a submission request
+func newSubmission(req *SubmitProjectRequest, teamMembersJSON string) (*models.Submission, error) {
+	submissionID, err := utils.GenerateSubmissionID()
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Submission{
+		ID:                submissionID,
+		ProjectName:       req.ProjectName,
+		Description:       req.Description,
+		PhotoLink:         req.PhotoLink,
+		Event:             req.Event,
+		Categories:        req.Categories,
+		TeamMembers:       teamMembersJSON,
+		GithubLink:        req.GithubLink,
+		WebsiteLink:       req.WebsiteLink,
+		PlayLink:          req.PlayLink,
+		HowToPlay:         req.HowToPlay,
+		AdditionalNotes:   req.AdditionalNotes,
+		ExtraFields:       models.ExtraFields(req.ExtraFields),
+		ContractAddresses: req.ContractAddresses,
+		Status:            "pending",
+		SubmittedAt:       time.Now(),
+	}, nil
+}
+
 // GetProjects retrieves projects with pagination and filtering
 func (s *ProjectService) GetProjects(req *GetProjectsRequest) (*GetProjectsResponse, error) {
 	// Set defaults
@@ -163,24 +271,42 @@ func (s *ProjectService) GetProjects(req *GetProjectsRequest) (*GetProjectsRespo
 
 	// Calculate offset
 	offset := (req.Page - 1) * req.Limit
+	req.Search = strings.TrimSpace(req.Search)
+
+	// Filtering by a parent category also matches its subcategories
+	taxonomy, err := s.categoryRepo.GetCategories()
+	if err != nil {
+		return nil, err
+	}
+	filterCategories := expandCategoryNames(taxonomy, utils.RemoveEmpty(req.Category))
 
 	// Get projects
-	projects, err := s.projectRepo.GetProjects(
-		offset, req.Limit, req.Category, req.Event, req.Award,
-		req.Search, req.SortBy, req.SortOrder,
-	)
+	page := pagination.Request{
+		SortBy: req.SortBy, SortOrder: req.SortOrder, Cursor: req.Cursor, Offset: offset, Limit: req.Limit,
+	}
+	projects, cursors, err := s.projectRepo.GetProjects(page, filterCategories, req.Event, req.Award, req.Search)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get total count
-	total, err := s.projectRepo.GetProjectsCount(req.Category, req.Event, req.Award, req.Search)
+	total, err := s.projectRepo.GetProjectsCount(filterCategories, req.Event, req.Award, req.Search)
 	if err != nil {
 		return nil, err
 	}
 
+	// Mark what matched in search results
+	if req.Search != "" {
+		if err := s.highlightMatches(projects, req.Search); err != nil {
+			return nil, err
+		}
+	}
+
 	// Get filter options
-	categories, _ := s.projectRepo.GetDistinctCategories()
+	categories := make([]string, len(taxonomy))
+	for i, category := range taxonomy {
+		categories[i] = category.Name
+	}
 	events, _ := s.projectRepo.GetDistinctEvents()
 	awards, _ := s.projectRepo.GetDistinctAwards()
 
@@ -194,6 +320,8 @@ func (s *ProjectService) GetProjects(req *GetProjectsRequest) (*GetProjectsRespo
 			Limit:      req.Limit,
 			Total:      int(total),
 			TotalPages: totalPages,
+			NextCursor: cursors.Next,
+			PrevCursor: cursors.Prev,
 		},
 		Filters: FilterInfo{
 			Categories: categories,
@@ -203,40 +331,674 @@ func (s *ProjectService) GetProjects(req *GetProjectsRequest) (*GetProjectsRespo
 	}, nil
 }
 
-// GetProject retrieves a single project by ID
+// Search matches come back from the database between these private-use characters, and are turned into
+// <mark> tags once the text is escaped. Project text may contain them too, so they are stripped from it
+// before highlighting
+const (
+	highlightStart = "\ue000"
+	highlightStop  = "\ue001"
+)
+
+// highlightMarker turns escaped highlight markers into <mark> tags
+var highlightMarker = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+// highlightMatches sets the search highlight of each project
+func (s *ProjectService) highlightMatches(projects []models.Project, search string) error {
+	ids := make([]uint, len(projects))
+	for i, project := range projects {
+		ids[i] = project.ID
+	}
+	rows, err := s.projectRepo.GetSearchHighlights(ids, search, highlightStart, highlightStop)
+	if err != nil {
+		return err
+	}
+
+	highlights := make(map[uint]*models.SearchHighlight, len(rows))
+	for _, row := range rows {
+		highlights[row.ID] = &models.SearchHighlight{
+			Name:        highlightMarker.Replace(html.EscapeString(row.Name)),
+			Description: highlightMarker.Replace(html.EscapeString(row.Description)),
+		}
+	}
+	for i := range projects {
+		projects[i].Highlight = highlights[projects[i].ID]
+	}
+	return nil
+}
+
+// GetProject retrieves a single published project by ID
 func (s *ProjectService) GetProject(id uint) (*models.Project, error) {
+	return s.projectRepo.GetPublishedProjectByID(id)
+}
+
+// GetProjectForAdmin retrieves a single project by ID, including unpublished ones
+func (s *ProjectService) GetProjectForAdmin(id uint) (*models.Project, error) {
 	return s.projectRepo.GetProjectByID(id)
 }
 
-// LikeProject increments the likes count for a project
-func (s *ProjectService) LikeProject(id uint) error {
-	// Check if project exists
-	_, err := s.projectRepo.GetProjectByID(id)
+// GetUnpublishedProjects retrieves approved projects waiting for their scheduled reveal
+func (s *ProjectService) GetUnpublishedProjects() ([]models.Project, error) {
+	return s.projectRepo.GetUnpublishedProjects()
+}
+
+// GetFeaturedProjects retrieves the published projects featured right now, in carousel order
+func (s *ProjectService) GetFeaturedProjects() ([]models.Project, error) {
+	return s.projectRepo.GetFeaturedProjects()
+}
+
+// GetFlaggedFeaturedProjects retrieves every project flagged as featured, including those outside their window
+func (s *ProjectService) GetFlaggedFeaturedProjects() ([]models.Project, error) {
+	return s.projectRepo.GetFlaggedFeaturedProjects()
+}
+
+// SetFeatured features a project within an optional time window, or takes it out of the carousel
+func (s *ProjectService) SetFeatured(id uint, req *FeatureProjectRequest) (*models.Project, error) {
+	if req.From != nil && req.Until != nil && !req.Until.After(*req.From) {
+		return nil, errors.New("INVALID_PROJECT: until must be after from")
+	}
+
+	// Unfeaturing clears the window so a later flag starts fresh
+	if !req.Featured {
+		req.Order, req.From, req.Until = 0, nil, nil
+	}
+	if err := s.projectRepo.UpdateFeatured(id, req.Featured, req.Order, req.From, req.Until); err != nil {
+		return nil, err
+	}
+	return s.projectRepo.GetProjectByID(id)
+}
+
+// OnPurge registers a hook that runs after a project has been permanently deleted
+func (s *ProjectService) OnPurge(hook PurgeHook) {
+	s.purgeHooks = append(s.purgeHooks, hook)
+}
+
+// ProjectETag identifies the current version of a project for If-Match preconditions
+func ProjectETag(project *models.Project) string {
+	return fmt.Sprintf(`"%d-%d"`, project.ID, project.UpdatedAt.UnixMicro())
+}
+
+// CreateProject creates a project directly, e.g. for programs that did not collect submissions
+func (s *ProjectService) CreateProject(req *CreateProjectRequest) (*models.Project, error) {
+	// The same field rules as for admin edits
+	for _, f := range []struct {
+		field string
+		value *string
+		isURL bool
+	}{
+		{"name", &req.Name, false},
+		{"description", &req.Description, false},
+		{"logo", &req.Logo, true},
+		{"howToPlay", &req.HowToPlay, false},
+		{"playUrl", &req.PlayURL, true},
+	} {
+		value, err := projectText(f.field, *f.value, f.isURL)
+		if err != nil {
+			return nil, err
+		}
+		*f.value = value
+	}
+	githubURL, err := optionalProjectURL("github", req.GithubURL)
 	if err != nil {
+		return nil, err
+	}
+	websiteURL, err := optionalProjectURL("website", req.WebsiteURL)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.resolveCategories(req.Categories)
+	if err != nil {
+		return nil, err
+	}
+	event, err := s.resolveEvent(req.Event)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateExtraFields(event, req.ExtraFields); err != nil {
+		return nil, err
+	}
+	publicFields, err := publicExtraFields(event, req.ExtraFields)
+	if err != nil {
+		return nil, err
+	}
+	team, err := validTeam(req.Team)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.projectRepo.GetProjectByName(req.Name); err == nil {
+		return nil, errors.New("DUPLICATE_PROJECT_NAME: Project with this name already exists")
+	} else if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+
+	project := &models.Project{
+		Name:         req.Name,
+		Logo:         req.Logo,
+		Description:  req.Description,
+		Categories:   categories,
+		Event:        event.Name,
+		EventID:      &event.ID,
+		HowToPlay:    req.HowToPlay,
+		PlayURL:      req.PlayURL,
+		GithubURL:    githubURL,
+		WebsiteURL:   websiteURL,
+		ExtraFields:  models.ExtraFields(req.ExtraFields),
+		PublicFields: publicFields,
+	}
+	for _, member := range team {
+		project.TeamMembers = append(project.TeamMembers, models.TeamMember{Name: member.Name, Twitter: member.Twitter})
+	}
+
+	now := time.Now()
+	if req.PublishAt != nil && req.PublishAt.After(now) {
+		project.PublishAt = req.PublishAt
+	} else {
+		project.PublishedAt = &now
+	}
+
+	if err := s.projectRepo.CreateProject(project); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			// Soft-deleted projects keep their names until they are purged
+			return nil, errors.New("DUPLICATE_PROJECT_NAME: Project with this name already exists")
+		}
+		return nil, err
+	}
+	return s.projectRepo.GetProjectByID(project.ID)
+}
+
+// UpdateProject applies an admin edit to a project and records each changed field in its change log.
+// etag must name the project's current version, or be "*" to overwrite whatever is stored.
+func (s *ProjectService) UpdateProject(id uint, etag string, req *UpdateProjectRequest, adminID uint) (*models.Project, error) {
+	if strings.TrimSpace(etag) == "" {
+		return nil, errors.New("PRECONDITION_REQUIRED: Send the project's ETag in If-Match to update it")
+	}
+	version, err := projectVersion(id, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.projectRepo.GetProjectByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if version != nil && !project.UpdatedAt.Equal(*version) {
+		return nil, errors.New("PRECONDITION_FAILED: The project has changed since it was loaded")
+	}
+
+	edit, err := s.diffProjectUpdate(project, req, adminActor(adminID))
+	if err != nil {
+		return nil, err
+	}
+	if len(edit.Columns) == 0 {
+		return project, nil
+	}
+
+	updated, err := s.projectRepo.UpdateProjectIfUnchanged(id, version, edit)
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, errors.New("DUPLICATE_PROJECT_NAME: Project with this name already exists")
+		}
+		return nil, err
+	}
+	if !updated {
+		return nil, errors.New("PRECONDITION_FAILED: The project has changed since it was loaded")
+	}
+	return s.projectRepo.GetProjectByID(id)
+}
+
+// AddTeamMember adds a member to a project's team; a non-empty etag must name the project's current version
+func (s *ProjectService) AddTeamMember(projectID uint, etag string, input models.TeamMemberInput, adminID uint) (*models.Project, error) {
+	project, version, err := s.projectAtVersion(projectID, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := validTeam(append(teamInputs(project.TeamMembers), input))
+	if err != nil {
+		return nil, err
+	}
+	member := team[len(team)-1]
+
+	log, err := newChangeLog("team", teamInputs(project.TeamMembers), team, adminActor(adminID))
+	if err != nil {
+		return nil, err
+	}
+	return s.editTeam(projectID, func() (bool, error) {
+		return s.projectRepo.AddTeamMember(&models.TeamMember{ProjectID: projectID, Name: member.Name, Twitter: member.Twitter}, version, log)
+	})
+}
+
+// UpdateTeamMember changes a team member's name or twitter handle; changing the handle relinks the member to
+// the builder of the new handle. A non-empty etag must name the project's current version.
+func (s *ProjectService) UpdateTeamMember(projectID, memberID uint, etag string, input models.TeamMemberInput, adminID uint) (*models.Project, error) {
+	project, version, err := s.projectAtVersion(projectID, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	index := -1
+	current := teamInputs(project.TeamMembers)
+	team := append([]models.TeamMemberInput(nil), current...)
+	for i := range project.TeamMembers {
+		if project.TeamMembers[i].ID == memberID {
+			index = i
+			team[i] = input
+		}
+	}
+	if index < 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	team, err = validTeam(team)
+	if err != nil {
+		return nil, err
+	}
+
+	member := project.TeamMembers[index]
+	member.Name, member.Twitter = team[index].Name, team[index].Twitter
+
+	log, err := newChangeLog("team", current, team, adminActor(adminID))
+	if err != nil {
+		return nil, err
+	}
+	return s.editTeam(projectID, func() (bool, error) {
+		return s.projectRepo.UpdateTeamMember(&member, version, log)
+	})
+}
+
+// RemoveTeamMember removes a member from a project's team; the last member cannot be removed.
+// A non-empty etag must name the project's current version.
+func (s *ProjectService) RemoveTeamMember(projectID, memberID uint, etag string, adminID uint) (*models.Project, error) {
+	project, version, err := s.projectAtVersion(projectID, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	found := false
+	var team []models.TeamMemberInput
+	for _, member := range project.TeamMembers {
+		if member.ID == memberID {
+			found = true
+			continue
+		}
+		team = append(team, models.TeamMemberInput{Name: member.Name, Twitter: member.Twitter})
+	}
+	if !found {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if len(team) == 0 {
+		return nil, errors.New("INVALID_TEAM_MEMBERS: A project needs at least one team member")
+	}
+
+	log, err := newChangeLog("team", teamInputs(project.TeamMembers), team, adminActor(adminID))
+	if err != nil {
+		return nil, err
+	}
+	return s.editTeam(projectID, func() (bool, error) {
+		return s.projectRepo.DeleteTeamMember(projectID, memberID, version, log)
+	})
+}
+
+// DeleteProject soft-deletes a project: it disappears everywhere but can be restored until it is purged
+func (s *ProjectService) DeleteProject(id uint) error {
+	return s.projectRepo.SoftDeleteProject(id)
+}
+
+// RestoreProject brings back a soft-deleted project
+func (s *ProjectService) RestoreProject(id uint) (*models.Project, error) {
+	if err := s.projectRepo.RestoreProject(id); err != nil {
+		return nil, err
+	}
+	return s.projectRepo.GetProjectByID(id)
+}
+
+// GetDeletedProjects lists soft-deleted projects, most recently deleted first
+func (s *ProjectService) GetDeletedProjects() ([]DeletedProject, error) {
+	projects, err := s.projectRepo.GetDeletedProjects()
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := make([]DeletedProject, len(projects))
+	for i, project := range projects {
+		deleted[i] = DeletedProject{Project: project, DeletedAt: project.DeletedAt.Time}
+	}
+	return deleted, nil
+}
+
+// PurgeProject permanently deletes a project. Only soft-deleted projects can be purged, so a purge
+// always follows a deliberate delete.
+func (s *ProjectService) PurgeProject(id uint) error {
+	project, err := s.projectRepo.GetDeletedProjectByID(id)
+	if err == gorm.ErrRecordNotFound {
+		if _, liveErr := s.projectRepo.GetProjectByID(id); liveErr == nil {
+			return errors.New("PROJECT_NOT_DELETED: Delete the project before purging it")
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := s.projectRepo.PurgeProject(id); err != nil {
 		return err
 	}
+	for _, hook := range s.purgeHooks {
+		hook(project)
+	}
+	return nil
+}
+
+// projectAtVersion loads a project and, when etag is not empty, checks that it names the current version.
+// The returned version is checked again when the change is written.
+func (s *ProjectService) projectAtVersion(id uint, etag string) (*models.Project, *time.Time, error) {
+	var version *time.Time
+	if strings.TrimSpace(etag) != "" {
+		var err error
+		if version, err = projectVersion(id, etag); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	project, err := s.projectRepo.GetProjectByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if version != nil && !project.UpdatedAt.Equal(*version) {
+		return nil, nil, errors.New("PRECONDITION_FAILED: The project has changed since it was loaded")
+	}
+	return project, version, nil
+}
+
+// editTeam runs a versioned team change and returns the updated project
+func (s *ProjectService) editTeam(projectID uint, change func() (bool, error)) (*models.Project, error) {
+	edited, err := change()
+	if err != nil {
+		return nil, err
+	}
+	if !edited {
+		return nil, errors.New("PRECONDITION_FAILED: The project has changed since it was loaded")
+	}
+	return s.projectRepo.GetProjectByID(projectID)
+}
+
+// diffProjectUpdate validates an admin edit and turns the fields it changes into column updates and change log entries
+func (s *ProjectService) diffProjectUpdate(project *models.Project, req *UpdateProjectRequest, changedBy string) (*repository.ProjectEdit, error) {
+	edit := &repository.ProjectEdit{Columns: map[string]interface{}{}}
+	record := func(field string, oldValue, newValue interface{}) error {
+		log, err := newChangeLog(field, oldValue, newValue, changedBy)
+		if err != nil {
+			return err
+		}
+		edit.Logs = append(edit.Logs, *log)
+		return nil
+	}
+
+	required := []struct {
+		field   string
+		column  string
+		current string
+		value   *string
+		isURL   bool
+	}{
+		{"name", "name", project.Name, req.Name, false},
+		{"description", "description", project.Description, req.Description, false},
+		{"logo", "logo", project.Logo, req.Logo, true},
+		{"howToPlay", "how_to_play", project.HowToPlay, req.HowToPlay, false},
+		{"playUrl", "play_url", project.PlayURL, req.PlayURL, true},
+	}
+	for _, f := range required {
+		if f.value == nil {
+			continue
+		}
+		value, err := projectText(f.field, *f.value, f.isURL)
+		if err != nil {
+			return nil, err
+		}
+		if value == f.current {
+			continue
+		}
+		if f.field == "name" {
+			if _, err := s.projectRepo.GetProjectByName(value); err == nil {
+				return nil, errors.New("DUPLICATE_PROJECT_NAME: Project with this name already exists")
+			} else if err != gorm.ErrRecordNotFound {
+				return nil, err
+			}
+		}
+		edit.Columns[f.column] = value
+		if f.field == "logo" {
+			// The thumbnail belonged to the previous, uploaded logo
+			edit.Columns["logo_thumbnail"] = ""
+		}
+		if err := record(f.field, f.current, value); err != nil {
+			return nil, err
+		}
+	}
+
+	optional := []struct {
+		field   string
+		column  string
+		current *string
+		value   *string
+	}{
+		{"github", "github_url", project.GithubURL, req.GithubURL},
+		{"website", "website_url", project.WebsiteURL, req.WebsiteURL},
+	}
+	for _, f := range optional {
+		if f.value == nil {
+			continue
+		}
+		stored, err := optionalProjectURL(f.field, f.value)
+		if err != nil {
+			return nil, err
+		}
+		if (f.current == nil && stored == nil) || (f.current != nil && stored != nil && *f.current == *stored) {
+			continue
+		}
+		edit.Columns[f.column] = stored
+		if err := record(f.field, f.current, stored); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Categories != nil {
+		categories, err := s.resolveCategories(req.Categories)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(categories, []string(project.Categories)) {
+			edit.Columns["categories"] = pq.StringArray(categories)
+			if err := record("categories", project.Categories, categories); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Extra fields are checked against the project's event, which may be changing too
+	if req.Event != nil || req.ExtraFields != nil {
+		var event *models.Event
+		var err error
+		if req.Event != nil {
+			event, err = s.resolveEvent(strings.TrimSpace(*req.Event))
+		} else if project.EventID != nil {
+			event, err = s.eventRepo.GetEventByID(*project.EventID)
+		} else {
+			event, err = s.resolveEvent(project.Event)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		extraFields := project.ExtraFields
+		if req.ExtraFields != nil {
+			extraFields = models.ExtraFields(req.ExtraFields)
+		}
+		if err := validateExtraFields(event, extraFields); err != nil {
+			return nil, err
+		}
+
+		if event.Name != project.Event || project.EventID == nil || *project.EventID != event.ID {
+			edit.Columns["event"] = event.Name
+			edit.Columns["event_id"] = &event.ID
+			if err := record("event", project.Event, event.Name); err != nil {
+				return nil, err
+			}
+		}
+		if req.ExtraFields != nil && !reflect.DeepEqual(map[string]interface{}(extraFields), map[string]interface{}(project.ExtraFields)) {
+			edit.Columns["extra_fields"] = extraFields
+			if err := record("extraFields", project.ExtraFields, extraFields); err != nil {
+				return nil, err
+			}
+		}
+
+		// The public subset follows both the values and the event's choice of public fields
+		if _, changed := edit.Columns["event_id"]; changed || edit.Columns["extra_fields"] != nil {
+			publicFields, err := publicExtraFields(event, extraFields)
+			if err != nil {
+				return nil, err
+			}
+			edit.Columns["public_extra_fields"] = publicFields
+		}
+	}
+
+	return edit, nil
+}
+
+// resolveCategories normalizes category names and aliases to the canonical names
+func (s *ProjectService) resolveCategories(names []string) ([]string, error) {
+	taxonomy, err := s.categoryRepo.GetCategories()
+	if err != nil {
+		return nil, err
+	}
+	categories, ok := resolveCategoryNames(taxonomy, names)
+	if !ok || len(categories) == 0 {
+		return nil, errors.New("INVALID_CATEGORIES: Invalid categories provided")
+	}
+	return categories, nil
+}
+
+// projectText trims a required project field and checks it is set and, for links, an http(s) URL
+func projectText(field, value string, isURL bool) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", errors.New("INVALID_PROJECT: " + field + " must not be empty")
+	}
+	if isURL && !validHTTPURL(value) {
+		return "", errors.New("INVALID_PROJECT: " + field + " must be an http(s) URL")
+	}
+	return value, nil
+}
+
+// optionalProjectURL trims an optional project link; nil and empty links are stored as nil
+func optionalProjectURL(field string, value *string) (*string, error) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return nil, nil
+	}
+	link := strings.TrimSpace(*value)
+	if !validHTTPURL(link) {
+		return nil, errors.New("INVALID_PROJECT: " + field + " must be an http(s) URL")
+	}
+	return &link, nil
+}
 
-	return s.projectRepo.IncrementLikes(id)
+// validTeam trims a team's members and checks that each has a name and a twitter handle not used twice
+func validTeam(members []models.TeamMemberInput) ([]models.TeamMemberInput, error) {
+	team := make([]models.TeamMemberInput, 0, len(members))
+	seen := make(map[string]bool)
+	for _, member := range members {
+		member.Name = strings.TrimSpace(member.Name)
+		member.Twitter = strings.TrimSpace(member.Twitter)
+		if member.Name == "" || member.Twitter == "" {
+			return nil, errors.New("INVALID_TEAM_MEMBERS: All team members must have name and twitter")
+		}
+		handle := utils.NormalizeTwitterHandle(member.Twitter)
+		if seen[handle] {
+			return nil, errors.New("INVALID_TEAM_MEMBERS: " + member.Twitter + " is already on the team")
+		}
+		seen[handle] = true
+		team = append(team, member)
+	}
+	if len(team) == 0 {
+		return nil, errors.New("INVALID_TEAM_MEMBERS: A project needs at least one team member")
+	}
+	return team, nil
 }
 
-// validateSubmissionRequest validates the submission request
-func (s *ProjectService) validateSubmissionRequest(req *SubmitProjectRequest) error {
-	// Validate categories
-	if !utils.ValidateCategories(req.Categories) {
-		return errors.New("INVALID_CATEGORIES: Invalid categories provided")
+// projectVersion parses an If-Match value into the project version it names; "*" matches any version
+func projectVersion(projectID uint, etag string) (*time.Time, error) {
+	etag = strings.TrimSpace(etag)
+	if etag == "*" {
+		return nil, nil
 	}
 
+	id, micros, ok := strings.Cut(strings.Trim(strings.TrimPrefix(etag, "W/"), `"`), "-")
+	if !ok || id != strconv.FormatUint(uint64(projectID), 10) {
+		return nil, errors.New("PRECONDITION_FAILED: The ETag does not belong to this project")
+	}
+	n, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, errors.New("PRECONDITION_FAILED: The ETag does not belong to this project")
+	}
+	version := time.UnixMicro(n)
+	return &version, nil
+}
+
+// adminActor names an admin in change logs
+func adminActor(adminID uint) string {
+	return "admin:" + strconv.FormatUint(uint64(adminID), 10)
+}
+
+// validateSubmissionRequest validates the submission request and resolves its event.
+// The event may be given by name or slug; req.Event is normalized to the event name.
+func (s *ProjectService) validateSubmissionRequest(req *SubmitProjectRequest) (*models.Event, error) {
+	// Validate categories, normalizing aliases to the canonical names
+	taxonomy, err := s.categoryRepo.GetCategories()
+	if err != nil {
+		return nil, err
+	}
+	categories, ok := resolveCategoryNames(taxonomy, req.Categories)
+	if !ok || len(categories) == 0 {
+		return nil, errors.New("INVALID_CATEGORIES: Invalid categories provided")
+	}
+	req.Categories = categories
+
 	// Validate event
-	if !utils.ValidateEvent(req.Event) {
-		return errors.New("INVALID_EVENT: Invalid event provided")
+	event, err := s.resolveEvent(req.Event)
+	if err != nil {
+		return nil, err
+	}
+	req.Event = event.Name
+
+	// Validate the event's custom fields
+	if err := validateExtraFields(event, req.ExtraFields); err != nil {
+		return nil, err
 	}
 
 	// Validate team members
 	for _, member := range req.TeamMembers {
 		if member.Name == "" || member.Twitter == "" {
-			return errors.New("INVALID_TEAM_MEMBERS: All team members must have name and twitter")
+			return nil, errors.New("INVALID_TEAM_MEMBERS: All team members must have name and twitter")
 		}
 	}
 
-	return nil
+	// Validate declared contracts, storing them lowercased
+	addresses, err := normalizeContractAddresses(req.ContractAddresses)
+	if err != nil {
+		return nil, err
+	}
+	req.ContractAddresses = addresses
+
+	return event, nil
+}
+
+// resolveEvent finds a published event by name or slug
+func (s *ProjectService) resolveEvent(nameOrSlug string) (*models.Event, error) {
+	event, err := findEventByNameOrSlug(s.eventRepo, nameOrSlug)
+	if err == gorm.ErrRecordNotFound || (err == nil && event.Status == "draft") {
+		return nil, errors.New("INVALID_EVENT: Invalid event provided")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return event, nil
 }
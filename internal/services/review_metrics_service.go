@@ -0,0 +1,271 @@
+package services
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/utils"
+)
+
+type ReviewMetricsService struct {
+	submissionRepo *repository.SubmissionRepository
+	calendar       *utils.BusinessCalendar
+	slaDays        int
+}
+
+func NewReviewMetricsService(submissionRepo *repository.SubmissionRepository, calendar *utils.BusinessCalendar, slaDays int) *ReviewMetricsService {
+	return &ReviewMetricsService{
+		submissionRepo: submissionRepo,
+		calendar:       calendar,
+		slaDays:        slaDays,
+	}
+}
+
+// GetReviewSLARequest represents the request for review SLA metrics
+type GetReviewSLARequest struct {
+	Windows     string `form:"windows"`     // Comma-separated windows such as "7d,30d,90d,all"
+	Percentiles string `form:"percentiles"` // Comma-separated percentiles such as "50,90,95"
+	Event       string `form:"event"`
+}
+
+// GetReviewSLAResponse represents the response for review SLA metrics
+type GetReviewSLAResponse struct {
+	Success               bool              `json:"success"`
+	SLATargetBusinessDays int               `json:"slaTargetBusinessDays"`
+	GeneratedAt           time.Time         `json:"generatedAt"`
+	Windows               []ReviewSLAWindow `json:"windows"`
+}
+
+// ReviewSLAWindow holds review metrics for submissions submitted within one time window
+type ReviewSLAWindow struct {
+	Window     string                     `json:"window"`
+	Since      *time.Time                 `json:"since,omitempty"`
+	Overall    ReviewSLAStats             `json:"overall"`
+	ByEvent    map[string]*ReviewSLAStats `json:"byEvent"`
+	ByReviewer map[string]*ReviewSLAStats `json:"byReviewer"`
+}
+
+// ReviewSLAStats holds review timing statistics, in business days, for a group of submissions
+type ReviewSLAStats struct {
+	Submissions       int                `json:"submissions"`
+	FirstReviewed     int                `json:"firstReviewed"`
+	Decided           int                `json:"decided"`
+	DecidedWithinSLA  int                `json:"decidedWithinSla"`
+	OverdueOpen       int                `json:"overdueOpen"`
+	SLAComplianceRate *float64           `json:"slaComplianceRate"`
+	TimeToFirstReview map[string]float64 `json:"timeToFirstReview"`
+	TimeToDecision    map[string]float64 `json:"timeToDecision"`
+
+	firstReviewDays []float64
+	decisionDays    []float64
+}
+
+// GetReviewSLA computes time-to-first-review and time-to-decision metrics per window, event and reviewer
+func (s *ReviewMetricsService) GetReviewSLA(req *GetReviewSLARequest) (*GetReviewSLAResponse, error) {
+	windows := utils.RemoveEmpty(strings.Split(req.Windows, ","))
+	if len(windows) == 0 {
+		windows = []string{"30d"}
+	}
+
+	percentiles, err := parsePercentiles(req.Percentiles)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	response := &GetReviewSLAResponse{
+		Success:               true,
+		SLATargetBusinessDays: s.slaDays,
+		GeneratedAt:           now,
+	}
+
+	for _, window := range windows {
+		since, err := parseMetricsWindow(window, now)
+		if err != nil {
+			return nil, err
+		}
+
+		submissions, err := s.submissionRepo.GetSubmissionsForReviewMetrics(since, req.Event)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Windows = append(response.Windows, s.buildWindow(window, since, submissions, percentiles, now))
+	}
+
+	return response, nil
+}
+
+// buildWindow aggregates the submissions of one window into overall, per-event and per-reviewer stats
+func (s *ReviewMetricsService) buildWindow(window string, since *time.Time, submissions []models.Submission, percentiles []float64, now time.Time) ReviewSLAWindow {
+	result := ReviewSLAWindow{
+		Window:     window,
+		Since:      since,
+		ByEvent:    make(map[string]*ReviewSLAStats),
+		ByReviewer: make(map[string]*ReviewSLAStats),
+	}
+
+	for _, submission := range submissions {
+		groups := []*ReviewSLAStats{&result.Overall}
+
+		if _, ok := result.ByEvent[submission.Event]; !ok {
+			result.ByEvent[submission.Event] = &ReviewSLAStats{}
+		}
+		groups = append(groups, result.ByEvent[submission.Event])
+
+		if submission.ReviewerID != nil {
+			reviewerKey := strconv.FormatUint(uint64(*submission.ReviewerID), 10)
+			if _, ok := result.ByReviewer[reviewerKey]; !ok {
+				result.ByReviewer[reviewerKey] = &ReviewSLAStats{}
+			}
+			groups = append(groups, result.ByReviewer[reviewerKey])
+		}
+
+		for _, stats := range groups {
+			s.addSubmission(stats, &submission, now)
+		}
+	}
+
+	result.Overall.finalize(percentiles)
+	for _, stats := range result.ByEvent {
+		stats.finalize(percentiles)
+	}
+	for _, stats := range result.ByReviewer {
+		stats.finalize(percentiles)
+	}
+
+	return result
+}
+
+// addSubmission records one submission's review timings in the stats
+func (s *ReviewMetricsService) addSubmission(stats *ReviewSLAStats, submission *models.Submission, now time.Time) {
+	stats.Submissions++
+
+	// The first review is when review started, or the decision itself if it skipped under_review
+	firstReviewAt := submission.ReviewStartedAt
+	if firstReviewAt == nil {
+		firstReviewAt = submission.ReviewedAt
+	}
+	if firstReviewAt != nil {
+		stats.FirstReviewed++
+		stats.firstReviewDays = append(stats.firstReviewDays, s.calendar.BusinessDays(submission.SubmittedAt, *firstReviewAt))
+	}
+
+	decided := submission.Status == "approved" || submission.Status == "rejected" || submission.Status == "requires_changes"
+	if decided && submission.ReviewedAt != nil {
+		days := s.calendar.BusinessDays(submission.SubmittedAt, *submission.ReviewedAt)
+		stats.Decided++
+		stats.decisionDays = append(stats.decisionDays, days)
+		if days <= float64(s.slaDays) {
+			stats.DecidedWithinSLA++
+		}
+		return
+	}
+
+	// Still awaiting a decision
+	if s.calendar.BusinessDays(submission.SubmittedAt, now) > float64(s.slaDays) {
+		stats.OverdueOpen++
+	}
+}
+
+// finalize computes percentiles and the compliance rate from the collected samples
+func (stats *ReviewSLAStats) finalize(percentiles []float64) {
+	stats.TimeToFirstReview = summarizeDurations(stats.firstReviewDays, percentiles)
+	stats.TimeToDecision = summarizeDurations(stats.decisionDays, percentiles)
+	if stats.Decided > 0 {
+		rate := float64(stats.DecidedWithinSLA) / float64(stats.Decided)
+		stats.SLAComplianceRate = &rate
+	}
+}
+
+// summarizeDurations returns the mean and requested percentiles of the samples, keyed like "p90"
+func summarizeDurations(samples []float64, percentiles []float64) map[string]float64 {
+	summary := make(map[string]float64)
+	if len(samples) == 0 {
+		return summary
+	}
+
+	sorted := append([]float64(nil), samples...)
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, sample := range sorted {
+		sum += sample
+	}
+	summary["mean"] = roundMetric(sum / float64(len(sorted)))
+
+	for _, p := range percentiles {
+		key := "p" + strconv.FormatFloat(p, 'f', -1, 64)
+		summary[key] = roundMetric(percentile(sorted, p))
+	}
+
+	return summary
+}
+
+// percentile returns the p-th percentile of sorted samples using linear interpolation
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	weight := rank - float64(lower)
+	return sorted[lower]*(1-weight) + sorted[upper]*weight
+}
+
+// roundMetric rounds a value to two decimals for readability
+func roundMetric(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+// parsePercentiles parses a comma-separated list of percentiles between 0 and 100
+func parsePercentiles(value string) ([]float64, error) {
+	parts := utils.RemoveEmpty(strings.Split(value, ","))
+	if len(parts) == 0 {
+		return []float64{50, 90, 95}, nil
+	}
+
+	percentiles := make([]float64, 0, len(parts))
+	for _, part := range parts {
+		p, err := strconv.ParseFloat(part, 64)
+		if err != nil || math.IsNaN(p) || math.IsInf(p, 0) || p < 0 || p > 100 {
+			return nil, errors.New("INVALID_PERCENTILE: Percentiles must be numbers between 0 and 100")
+		}
+		percentiles = append(percentiles, p)
+	}
+	return percentiles, nil
+}
+
+// parseMetricsWindow converts a window like "7d", "4w" or "all" into its start time
+func parseMetricsWindow(window string, now time.Time) (*time.Time, error) {
+	if window == "all" {
+		return nil, nil
+	}
+
+	if len(window) >= 2 {
+		amount, err := strconv.Atoi(window[:len(window)-1])
+		if err == nil && amount > 0 {
+			var since time.Time
+			switch window[len(window)-1] {
+			case 'd':
+				since = now.AddDate(0, 0, -amount)
+			case 'w':
+				since = now.AddDate(0, 0, -7*amount)
+			case 'm':
+				since = now.AddDate(0, -amount, 0)
+			}
+			if !since.IsZero() {
+				return &since, nil
+			}
+		}
+	}
+
+	return nil, errors.New("INVALID_WINDOW: Windows must look like 7d, 4w, 3m or all")
+}
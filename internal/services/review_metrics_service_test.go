@@ -0,0 +1,118 @@
+package services
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		name   string
+		sorted []float64
+		p      float64
+		want   float64
+	}{
+		{name: "single sample", sorted: []float64{4}, p: 90, want: 4},
+		{name: "minimum", sorted: []float64{1, 2, 3, 4}, p: 0, want: 1},
+		{name: "maximum", sorted: []float64{1, 2, 3, 4}, p: 100, want: 4},
+		{name: "median of odd count", sorted: []float64{1, 5, 9}, p: 50, want: 5},
+		{name: "median interpolates", sorted: []float64{1, 2, 3, 4}, p: 50, want: 2.5},
+		{name: "p90 interpolates", sorted: []float64{0, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100}, p: 95, want: 95},
+		{name: "fractional percentile", sorted: []float64{10, 20}, p: 12.5, want: 11.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := percentile(tt.sorted, tt.p); got != tt.want {
+				t.Errorf("percentile(%v, %v) = %v, want %v", tt.sorted, tt.p, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePercentiles(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    []float64
+		wantErr bool
+	}{
+		{value: "", want: []float64{50, 90, 95}},
+		{value: " , ", want: []float64{50, 90, 95}},
+		{value: "50", want: []float64{50}},
+		{value: "0, 99.9 ,100", want: []float64{0, 99.9, 100}},
+		{value: "-1", wantErr: true},
+		{value: "100.5", wantErr: true},
+		{value: "p90", wantErr: true},
+		{value: "NaN", wantErr: true},
+		{value: "nan", wantErr: true},
+		{value: "Inf", wantErr: true},
+		{value: "-Inf", wantErr: true},
+		{value: "50,NaN", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parsePercentiles(tt.value)
+			if tt.wantErr {
+				if err == nil || !strings.HasPrefix(err.Error(), "INVALID_PERCENTILE:") {
+					t.Fatalf("parsePercentiles(%q) = %v, %v; want INVALID_PERCENTILE", tt.value, got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsePercentiles(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMetricsWindow(t *testing.T) {
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		window  string
+		want    *time.Time
+		wantErr bool
+	}{
+		{window: "all"},
+		{window: "7d", want: timePtr(time.Date(2024, 3, 24, 12, 0, 0, 0, time.UTC))},
+		{window: "1d", want: timePtr(time.Date(2024, 3, 30, 12, 0, 0, 0, time.UTC))},
+		{window: "4w", want: timePtr(time.Date(2024, 3, 3, 12, 0, 0, 0, time.UTC))},
+		{window: "1m", want: timePtr(time.Date(2024, 3, 2, 12, 0, 0, 0, time.UTC))}, // February 31st normalizes to March 2nd
+		{window: "12m", want: timePtr(time.Date(2023, 3, 31, 12, 0, 0, 0, time.UTC))},
+		{window: "", wantErr: true},
+		{window: "d", wantErr: true},
+		{window: "0d", wantErr: true},
+		{window: "-3d", wantErr: true},
+		{window: "7y", wantErr: true},
+		{window: "7", wantErr: true},
+		{window: "ALL", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.window, func(t *testing.T) {
+			got, err := parseMetricsWindow(tt.window, now)
+			if tt.wantErr {
+				if err == nil || !strings.HasPrefix(err.Error(), "INVALID_WINDOW:") {
+					t.Fatalf("parseMetricsWindow(%q) = %v, %v; want INVALID_WINDOW", tt.window, got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && !got.Equal(*tt.want)) {
+				t.Errorf("parseMetricsWindow(%q) = %v, want %v", tt.window, got, tt.want)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
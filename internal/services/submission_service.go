@@ -2,33 +2,73 @@ package services
 
 import (
 	"encoding/json"
+	"log"
 	"math"
 	"time"
 
 	"errors"
 	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/pagination"
 	"monad-devhub-be/internal/repository"
+	"monad-devhub-be/internal/utils"
+
+	"gorm.io/gorm"
 )
 
 type SubmissionService struct {
 	submissionRepo *repository.SubmissionRepository
 	projectRepo    *repository.ProjectRepository
+	awardRepo      *repository.AwardRepository
+	eventRepo      *repository.EventRepository
+	calendar       *utils.BusinessCalendar
+	slaDays        int
+	publishHooks   []PublishHook
+	publishedInTx  *[]*models.Project // Projects published in the current transaction, set on transaction copies
 }
 
-func NewSubmissionService(submissionRepo *repository.SubmissionRepository, projectRepo *repository.ProjectRepository) *SubmissionService {
+// PublishHook is called after a project becomes publicly visible
+type PublishHook func(project *models.Project)
+
+func NewSubmissionService(submissionRepo *repository.SubmissionRepository, projectRepo *repository.ProjectRepository, awardRepo *repository.AwardRepository, eventRepo *repository.EventRepository, calendar *utils.BusinessCalendar, slaDays int) *SubmissionService {
 	return &SubmissionService{
 		submissionRepo: submissionRepo,
 		projectRepo:    projectRepo,
+		awardRepo:      awardRepo,
+		eventRepo:      eventRepo,
+		calendar:       calendar,
+		slaDays:        slaDays,
 	}
 }
 
 // GetSubmissionsRequest represents the request for getting submissions
 type GetSubmissionsRequest struct {
-	Page      int    `form:"page" binding:"min=1"`
-	Limit     int    `form:"limit" binding:"min=1,max=100"`
-	Status    string `form:"status"`
-	SortBy    string `form:"sortBy"`
-	SortOrder string `form:"sortOrder"`
+	Page          int        `form:"page" binding:"min=1"`
+	Limit         int        `form:"limit" binding:"min=1,max=100"`
+	Status        string     `form:"status"`
+	Event         string     `form:"event"`
+	Category      []string   `form:"category"`
+	ReviewerID    *uint      `form:"reviewerId"`
+	SubmittedFrom *time.Time `form:"submittedFrom"`
+	SubmittedTo   *time.Time `form:"submittedTo"`
+	HasGithub     *bool      `form:"hasGithub"`
+	Search        string     `form:"search"`
+	SortBy        string     `form:"sortBy"`
+	SortOrder     string     `form:"sortOrder"`
+	Cursor        string     `form:"cursor"`
+}
+
+// Filter converts the request's filter parameters into a repository filter
+func (req *GetSubmissionsRequest) Filter() repository.SubmissionFilter {
+	return repository.SubmissionFilter{
+		Status:        req.Status,
+		Event:         req.Event,
+		Categories:    req.Category,
+		ReviewerID:    req.ReviewerID,
+		SubmittedFrom: req.SubmittedFrom,
+		SubmittedTo:   req.SubmittedTo,
+		HasGithub:     req.HasGithub,
+		Search:        req.Search,
+	}
 }
 
 // GetSubmissionsResponse represents the response for getting submissions
@@ -53,6 +93,8 @@ type SubmissionWithTeamMembers struct {
 	PlayLink          string                   `json:"playLink"`
 	HowToPlay         string                   `json:"howToPlay"`
 	AdditionalNotes   *string                  `json:"additionalNotes,omitempty"`
+	ExtraFields       models.ExtraFields       `json:"extraFields,omitempty"`
+	ContractAddresses []string                 `json:"contractAddresses,omitempty"`
 	Status            string                   `json:"status"`
 	ReviewerID        *uint                    `json:"reviewerId,omitempty"`
 	Feedback          *string                  `json:"feedback,omitempty"`
@@ -63,6 +105,11 @@ type SubmissionWithTeamMembers struct {
 	PublishedAt       *string                  `json:"publishedAt,omitempty"`
 	ApprovedProjectID *uint                    `json:"approvedProjectId,omitempty"`
 	ApprovedProject   *models.Project          `json:"project,omitempty"`
+	SLADueAt          *string                  `json:"slaDueAt,omitempty"`
+	Overdue           bool                     `json:"overdue,omitempty"`
+	ExternalSource    *string                  `json:"externalSource,omitempty"`
+	ExternalID        *string                  `json:"externalId,omitempty"`
+	OwnerAddress      *string                  `json:"ownerAddress,omitempty"`
 }
 
 // GetSubmissions retrieves submissions with pagination and filtering
@@ -78,70 +125,36 @@ func (s *SubmissionService) GetSubmissions(req *GetSubmissionsRequest) (*GetSubm
 	// Calculate offset
 	offset := (req.Page - 1) * req.Limit
 
+	filter := req.Filter()
+
 	// Get submissions from repository
-	submissions, err := s.submissionRepo.GetSubmissions(offset, req.Limit, req.Status, req.SortBy, req.SortOrder)
+	page := pagination.Request{
+		SortBy: req.SortBy, SortOrder: req.SortOrder, Cursor: req.Cursor, Offset: offset, Limit: req.Limit,
+	}
+	submissions, cursors, err := s.submissionRepo.GetSubmissions(page, filter)
 	if err != nil {
 		return nil, err
 	}
 
 	// Get total count
-	total, err := s.submissionRepo.GetSubmissionsCount(req.Status)
+	total, err := s.submissionRepo.GetSubmissionsCount(filter)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get statistics
-	stats, err := s.submissionRepo.GetSubmissionStats()
+	// Get statistics for the active filters
+	stats, err := s.submissionRepo.GetSubmissionStats(filter)
 	if err != nil {
 		return nil, err
 	}
 
 	// Convert submissions to response format with parsed team members
 	var submissionResponses []SubmissionWithTeamMembers
-	for _, submission := range submissions {
-		// Parse team members JSON
-		var teamMembers []models.TeamMemberInput
-		if submission.TeamMembers != "" {
-			json.Unmarshal([]byte(submission.TeamMembers), &teamMembers)
-		}
-
-		submissionResponse := SubmissionWithTeamMembers{
-			ID:                submission.ID,
-			ProjectName:       submission.ProjectName,
-			Description:       submission.Description,
-			PhotoLink:         submission.PhotoLink,
-			Event:             submission.Event,
-			Categories:        submission.Categories,
-			TeamMembers:       teamMembers,
-			GithubLink:        submission.GithubLink,
-			WebsiteLink:       submission.WebsiteLink,
-			PlayLink:          submission.PlayLink,
-			HowToPlay:         submission.HowToPlay,
-			AdditionalNotes:   submission.AdditionalNotes,
-			Status:            submission.Status,
-			ReviewerID:        submission.ReviewerID,
-			Feedback:          submission.Feedback,
-			ChangesRequested:  submission.ChangesRequested,
-			SubmittedAt:       submission.SubmittedAt.Format("2006-01-02T15:04:05Z"),
-			ApprovedProjectID: submission.ApprovedProjectID,
-			ApprovedProject:   submission.ApprovedProject,
-		}
-
-		// Format optional timestamps
-		if submission.ReviewStartedAt != nil {
-			timestamp := submission.ReviewStartedAt.Format("2006-01-02T15:04:05Z")
-			submissionResponse.ReviewStartedAt = &timestamp
-		}
-		if submission.ReviewedAt != nil {
-			timestamp := submission.ReviewedAt.Format("2006-01-02T15:04:05Z")
-			submissionResponse.ReviewedAt = &timestamp
-		}
-		if submission.PublishedAt != nil {
-			timestamp := submission.PublishedAt.Format("2006-01-02T15:04:05Z")
-			submissionResponse.PublishedAt = &timestamp
-		}
-
-		submissionResponses = append(submissionResponses, submissionResponse)
+	now := time.Now()
+	for i := range submissions {
+		submissionResponse := toSubmissionResponse(&submissions[i])
+		s.annotateSLA(submissionResponse, &submissions[i], now)
+		submissionResponses = append(submissionResponses, *submissionResponse)
 	}
 
 	// Calculate total pages
@@ -155,6 +168,8 @@ func (s *SubmissionService) GetSubmissions(req *GetSubmissionsRequest) (*GetSubm
 			Limit:      req.Limit,
 			Total:      int(total),
 			TotalPages: totalPages,
+			NextCursor: cursors.Next,
+			PrevCursor: cursors.Prev,
 		},
 		Stats: stats,
 	}, nil
@@ -167,6 +182,70 @@ func (s *SubmissionService) GetSubmissionByID(submissionID string) (*SubmissionW
 		return nil, err
 	}
 
+	return toSubmissionResponse(submission), nil
+}
+
+// GetSubmissionStatus retrieves a submission redacted for the caller.
+// Admins see everything; callers presenting the submission's secret token, or signed in as the
+// builder who submitted it, see owner fields.
+func (s *SubmissionService) GetSubmissionStatus(submissionID, accessToken, builderAddress string, isAdmin bool) (*SubmissionWithTeamMembers, SubmissionViewer, error) {
+	submission, err := s.submissionRepo.GetSubmissionByID(submissionID)
+	if err != nil {
+		return nil, ViewerPublic, err
+	}
+
+	viewer := ViewerPublic
+	if isAdmin {
+		viewer = ViewerAdmin
+	} else if utils.SecretTokenMatches(accessToken, submission.AccessTokenHash) {
+		viewer = ViewerOwner
+	} else if builderAddress != "" && submission.OwnerAddress != nil && *submission.OwnerAddress == builderAddress {
+		viewer = ViewerOwner
+	}
+
+	submissionResponse := toSubmissionResponse(submission)
+	s.annotateSLA(submissionResponse, submission, time.Now())
+	submissionResponse.RedactFor(viewer)
+
+	return submissionResponse, viewer, nil
+}
+
+// RegenerateSubmissionToken issues a new secret token for a submission, invalidating the old one
+func (s *SubmissionService) RegenerateSubmissionToken(submissionID string) (string, error) {
+	submission, err := s.submissionRepo.GetSubmissionByID(submissionID)
+	if err != nil {
+		return "", err
+	}
+
+	token, tokenHash, err := utils.GenerateSecretToken()
+	if err != nil {
+		return "", err
+	}
+
+	submission.AccessTokenHash = tokenHash
+	if err := s.submissionRepo.UpdateSubmission(submission); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// annotateSLA sets the review due date and flags open submissions that missed it
+func (s *SubmissionService) annotateSLA(submissionResponse *SubmissionWithTeamMembers, submission *models.Submission, now time.Time) {
+	if s.calendar == nil || s.slaDays <= 0 {
+		return
+	}
+
+	dueAt := s.calendar.AddBusinessDays(submission.SubmittedAt, s.slaDays)
+	timestamp := dueAt.UTC().Format("2006-01-02T15:04:05Z")
+	submissionResponse.SLADueAt = &timestamp
+
+	awaitingDecision := submission.Status == "pending" || submission.Status == "under_review"
+	submissionResponse.Overdue = awaitingDecision && now.After(dueAt)
+}
+
+// toSubmissionResponse converts a submission into its response format with parsed team members
+func toSubmissionResponse(submission *models.Submission) *SubmissionWithTeamMembers {
 	// Parse team members JSON
 	var teamMembers []models.TeamMemberInput
 	if submission.TeamMembers != "" {
@@ -186,6 +265,8 @@ func (s *SubmissionService) GetSubmissionByID(submissionID string) (*SubmissionW
 		PlayLink:          submission.PlayLink,
 		HowToPlay:         submission.HowToPlay,
 		AdditionalNotes:   submission.AdditionalNotes,
+		ExtraFields:       submission.ExtraFields,
+		ContractAddresses: submission.ContractAddresses,
 		Status:            submission.Status,
 		ReviewerID:        submission.ReviewerID,
 		Feedback:          submission.Feedback,
@@ -193,6 +274,9 @@ func (s *SubmissionService) GetSubmissionByID(submissionID string) (*SubmissionW
 		SubmittedAt:       submission.SubmittedAt.Format("2006-01-02T15:04:05Z"),
 		ApprovedProjectID: submission.ApprovedProjectID,
 		ApprovedProject:   submission.ApprovedProject,
+		ExternalSource:    submission.ExternalSource,
+		ExternalID:        submission.ExternalID,
+		OwnerAddress:      submission.OwnerAddress,
 	}
 
 	// Format optional timestamps
@@ -209,11 +293,20 @@ func (s *SubmissionService) GetSubmissionByID(submissionID string) (*SubmissionW
 		submissionResponse.PublishedAt = &timestamp
 	}
 
-	return submissionResponse, nil
+	return submissionResponse
+}
+
+// UpdateSubmissionStatus updates the status of a submission.
+// Approvals with a future publishAt create the project hidden until the scheduler publishes it.
+func (s *SubmissionService) UpdateSubmissionStatus(submissionID string, status string, feedback *string, changesRequested []string, reviewerID *uint, publishAt *time.Time) error {
+	// Run the review atomically so an approval never leaves an orphaned project behind
+	return s.inTransaction(func(txService *SubmissionService) error {
+		return txService.applyReview(submissionID, status, feedback, changesRequested, reviewerID, publishAt)
+	})
 }
 
-// UpdateSubmissionStatus updates the status of a submission
-func (s *SubmissionService) UpdateSubmissionStatus(submissionID string, status string, feedback *string, changesRequested []string, reviewerID *uint) error {
+// applyReview applies a review decision to a single submission
+func (s *SubmissionService) applyReview(submissionID string, status string, feedback *string, changesRequested []string, reviewerID *uint, publishAt *time.Time) error {
 	submission, err := s.submissionRepo.GetSubmissionByID(submissionID)
 	if err != nil {
 		return err
@@ -239,23 +332,163 @@ func (s *SubmissionService) UpdateSubmissionStatus(submissionID string, status s
 		submission.ReviewedAt = &now
 		// If being approved for the first time and no project exists yet
 		if status == "approved" && previousStatus != "approved" && submission.ApprovedProjectID == nil {
-			if err := s.createProjectFromSubmission(submission); err != nil {
+			project, err := s.createProjectFromSubmission(submission)
+			if err != nil {
+				return err
+			}
+			if publishAt != nil && publishAt.After(now) {
+				// Hold the project back until its scheduled reveal
+				project.PublishAt = publishAt
+				if err := s.projectRepo.UpdateProject(project); err != nil {
+					return err
+				}
+			} else if _, err := s.publishProject(project, submission, now, false); err != nil {
+				return err
+			}
+		} else if status == "approved" && publishAt != nil && submission.ApprovedProject != nil && submission.ApprovedProject.PublishedAt == nil {
+			// Reschedule a project that is approved but not yet published
+			project := submission.ApprovedProject
+			if publishAt.After(now) {
+				project.PublishAt = publishAt
+				if err := s.projectRepo.UpdateProject(project); err != nil {
+					return err
+				}
+			} else if _, err := s.publishProject(project, submission, now, false); err != nil {
 				return err
 			}
-			submission.PublishedAt = &now
 		}
 	}
 
 	return s.submissionRepo.UpdateSubmission(submission)
 }
 
-// createProjectFromSubmission creates a new project from an approved submission
-func (s *SubmissionService) createProjectFromSubmission(submission *models.Submission) error {
+// OnPublish registers a hook that runs after a project becomes publicly visible
+func (s *SubmissionService) OnPublish(hook PublishHook) {
+	s.publishHooks = append(s.publishHooks, hook)
+}
+
+// PublishDueProjects publishes every scheduled project whose publish time has passed
+func (s *SubmissionService) PublishDueProjects(now time.Time) (int, error) {
+	projects, err := s.projectRepo.GetProjectsDueForPublishing(now)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for i := range projects {
+		project := &projects[i]
+		claimed := false
+		err := s.inTransaction(func(txService *SubmissionService) error {
+			var submission *models.Submission
+			if project.SubmissionID != nil {
+				found, lookupErr := txService.submissionRepo.GetSubmissionByID(*project.SubmissionID)
+				if lookupErr != nil && lookupErr != gorm.ErrRecordNotFound {
+					return lookupErr
+				}
+				submission = found
+			}
+			var err error
+			if claimed, err = txService.publishProject(project, submission, now, true); err != nil || !claimed {
+				return err
+			}
+			if submission != nil {
+				return txService.submissionRepo.UpdateSubmission(submission)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Printf("Failed to publish scheduled project %d: %v", project.ID, err)
+			continue
+		}
+		if claimed {
+			published++
+		}
+	}
+
+	return published, nil
+}
+
+// RunPublishScheduler periodically publishes scheduled projects until the process exits
+func (s *SubmissionService) RunPublishScheduler(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		published, err := s.PublishDueProjects(time.Now())
+		if err != nil {
+			log.Printf("Failed to run publish scheduler: %v", err)
+		} else if published > 0 {
+			log.Printf("Published %d scheduled projects", published)
+		}
+		<-ticker.C
+	}
+}
+
+// publishProject makes a project publicly visible and queues the publish hooks. The project is published with a
+// conditional update, so a project is only ever published, and its hooks queued, once; with dueOnly it must also
+// still be scheduled for now or earlier. It reports whether this call published the project
+func (s *SubmissionService) publishProject(project *models.Project, submission *models.Submission, now time.Time, dueOnly bool) (bool, error) {
+	published, err := s.projectRepo.MarkProjectPublished(project.ID, now, dueOnly)
+	if err != nil || !published {
+		return false, err
+	}
+	project.PublishedAt = &now
+	project.PublishAt = nil
+
+	if submission != nil {
+		submission.PublishedAt = &now
+	}
+
+	*s.publishedInTx = append(*s.publishedInTx, project)
+	return true, nil
+}
+
+// inTransaction runs fn with a copy of the service whose repositories share one transaction.
+// Publish hooks for projects published inside the outermost transaction run only after it commits.
+func (s *SubmissionService) inTransaction(fn func(txService *SubmissionService) error) error {
+	outermost := s.publishedInTx == nil
+	published := []*models.Project{}
+
+	err := s.submissionRepo.Transaction(func(tx *gorm.DB) error {
+		txService := *s
+		txService.submissionRepo = s.submissionRepo.WithTx(tx)
+		txService.projectRepo = s.projectRepo.WithTx(tx)
+		txService.awardRepo = s.awardRepo.WithTx(tx)
+		if outermost {
+			txService.publishedInTx = &published
+		}
+		return fn(&txService)
+	})
+
+	if err == nil && outermost {
+		for _, project := range published {
+			for _, hook := range s.publishHooks {
+				hook(project)
+			}
+		}
+	}
+	return err
+}
+
+// createProjectFromSubmission creates a new, not yet published project from an approved submission
+func (s *SubmissionService) createProjectFromSubmission(submission *models.Submission) (*models.Project, error) {
 	// Parse team members from JSON
 	var teamMembersInput []models.TeamMemberInput
 	if submission.TeamMembers != "" {
 		if err := json.Unmarshal([]byte(submission.TeamMembers), &teamMembersInput); err != nil {
-			return err
+			return nil, err
+		}
+	}
+
+	// Only the extra fields the event marks public are shown on the project
+	var publicFields models.ExtraFields
+	if submission.EventID != nil && len(submission.ExtraFields) > 0 {
+		event, err := s.eventRepo.GetEventByID(*submission.EventID)
+		if err != nil {
+			return nil, err
+		}
+		if publicFields, err = publicExtraFields(event, submission.ExtraFields); err != nil {
+			return nil, err
 		}
 	}
 
@@ -266,19 +499,23 @@ func (s *SubmissionService) createProjectFromSubmission(submission *models.Submi
 		Description:  submission.Description,
 		Categories:   submission.Categories,
 		Event:        submission.Event,
-		Award:        "", // Can be set later
+		EventID:      submission.EventID,
 		Likes:        0,
 		Comments:     0,
 		HowToPlay:    submission.HowToPlay,
 		PlayURL:      submission.PlayLink,
 		GithubURL:    submission.GithubLink,
 		WebsiteURL:   submission.WebsiteLink,
+		ExtraFields:  submission.ExtraFields,
+		PublicFields: publicFields,
+		Contracts:    pendingContracts(submission.ContractAddresses),
 		SubmissionID: &submission.ID,
+		OwnerAddress: submission.OwnerAddress,
 	}
 
 	// Create the project in database
 	if err := s.projectRepo.CreateProject(project); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Create team members for the project
@@ -297,17 +534,18 @@ func (s *SubmissionService) createProjectFromSubmission(submission *models.Submi
 	if len(teamMembers) > 0 {
 		project.TeamMembers = teamMembers
 		if err := s.projectRepo.UpdateProject(project); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	// Link the submission to the created project
 	submission.ApprovedProjectID = &project.ID
 
-	return nil
+	return project, nil
 }
 
-// UpdateProjectExtras updates project award and team member photos for an approved submission
+// UpdateProjectExtras grants an award and updates team member photos for an approved submission.
+// The award is looked up by name within the project's event and created on first use.
 func (s *SubmissionService) UpdateProjectExtras(submissionID string, award *string, teamPhotos []map[string]string) error {
 	// Get submission
 	submission, err := s.submissionRepo.GetSubmissionByID(submissionID)
@@ -326,11 +564,6 @@ func (s *SubmissionService) UpdateProjectExtras(submissionID string, award *stri
 		return errors.New("project not found")
 	}
 
-	// Update award if provided and not empty
-	if award != nil && *award != "" {
-		project.Award = *award
-	}
-
 	// Update team member photos if provided
 	if len(teamPhotos) > 0 {
 		// Create a map of member names to photo URLs for quick lookup
@@ -350,12 +583,149 @@ func (s *SubmissionService) UpdateProjectExtras(submissionID string, award *stri
 				// Only update if photoUrl is not empty (this check is redundant now but kept for safety)
 				if photoUrl != "" {
 					project.TeamMembers[i].Image = photoUrl
+					project.TeamMembers[i].ImageThumbnail = "" // Pasted URLs have no thumbnail
 				}
 			}
 			// If photoUrl is empty or doesn't exist in map, leave existing image unchanged
 		}
 	}
 
-	// Save the updated project (now with proper association handling)
-	return s.projectRepo.UpdateProject(project)
+	// The award and the photos are saved together so a failure leaves the project unchanged
+	return s.awardRepo.Transaction(func(tx *gorm.DB) error {
+		if award != nil && *award != "" {
+			if _, err := grantAwardByName(s.awardRepo.WithTx(tx), project, *award, nil); err != nil {
+				return err
+			}
+		}
+		return s.projectRepo.WithTx(tx).UpdateProject(project)
+	})
+}
+
+// BulkReviewRequest represents a review decision applied to many submissions at once
+type BulkReviewRequest struct {
+	SubmissionIDs    []string   `json:"submissionIds" binding:"required,min=1,max=500"`
+	Status           string     `json:"status" binding:"required,oneof=pending under_review approved rejected requires_changes"`
+	Feedback         *string    `json:"feedback,omitempty"`
+	ChangesRequested []string   `json:"changesRequested,omitempty"`
+	ReviewerID       uint       `json:"reviewerId" binding:"required"`
+	PublishAt        *time.Time `json:"publishAt,omitempty"` // Reveal approved projects together at this time
+	Transactional    bool       `json:"transactional"`       // All-or-nothing: roll back every item if any fails
+}
+
+// BulkReviewItemResult represents the outcome of reviewing a single submission in a batch
+type BulkReviewItemResult struct {
+	SubmissionID string            `json:"submissionId"`
+	Success      bool              `json:"success"`
+	Status       string            `json:"status,omitempty"`
+	Error        map[string]string `json:"error,omitempty"`
+}
+
+// BulkReviewResponse represents the response for a bulk review
+type BulkReviewResponse struct {
+	Success       bool                   `json:"success"`
+	Transactional bool                   `json:"transactional"`
+	RolledBack    bool                   `json:"rolledBack"`
+	Total         int                    `json:"total"`
+	Succeeded     int                    `json:"succeeded"`
+	Failed        int                    `json:"failed"`
+	Results       []BulkReviewItemResult `json:"results"`
+}
+
+// errBulkReviewFailed aborts an all-or-nothing bulk review transaction
+var errBulkReviewFailed = errors.New("BULK_REVIEW_FAILED: One or more submissions could not be reviewed")
+
+// BulkReviewSubmissions applies the same review decision to many submissions
+func (s *SubmissionService) BulkReviewSubmissions(req *BulkReviewRequest) (*BulkReviewResponse, error) {
+	response := &BulkReviewResponse{
+		Transactional: req.Transactional,
+		Total:         len(req.SubmissionIDs),
+	}
+
+	if !req.Transactional {
+		// Each submission is reviewed in its own transaction
+		response.Results = s.reviewEach(req)
+	} else {
+		// Every submission shares one transaction; each item gets a savepoint so all errors are reported
+		err := s.inTransaction(func(txService *SubmissionService) error {
+			response.Results = txService.reviewEach(req)
+			for _, result := range response.Results {
+				if !result.Success {
+					return errBulkReviewFailed
+				}
+			}
+			return nil
+		})
+		if err != nil && err != errBulkReviewFailed {
+			return nil, err
+		}
+		if err == errBulkReviewFailed {
+			response.RolledBack = true
+			for i := range response.Results {
+				if response.Results[i].Success {
+					response.Results[i].Success = false
+					response.Results[i].Status = ""
+					response.Results[i].Error = map[string]string{
+						"code":    "ROLLED_BACK",
+						"message": "Not applied because another submission in the batch failed",
+					}
+				}
+			}
+		}
+	}
+
+	for _, result := range response.Results {
+		if result.Success {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+	response.Success = response.Failed == 0
+
+	return response, nil
+}
+
+// reviewEach reviews every submission in the request and collects per-item results
+func (s *SubmissionService) reviewEach(req *BulkReviewRequest) []BulkReviewItemResult {
+	results := make([]BulkReviewItemResult, 0, len(req.SubmissionIDs))
+	seen := make(map[string]bool)
+
+	for _, submissionID := range req.SubmissionIDs {
+		result := BulkReviewItemResult{SubmissionID: submissionID}
+
+		switch {
+		case !utils.ValidateSubmissionID(submissionID):
+			result.Error = map[string]string{
+				"code":    "INVALID_SUBMISSION_ID",
+				"message": "Invalid submission ID. Check it for typos",
+			}
+		case seen[submissionID]:
+			result.Error = map[string]string{
+				"code":    "DUPLICATE_IN_REQUEST",
+				"message": "Submission ID appears more than once in this request",
+			}
+		default:
+			reviewerID := req.ReviewerID
+			err := s.UpdateSubmissionStatus(submissionID, req.Status, req.Feedback, req.ChangesRequested, &reviewerID, req.PublishAt)
+			if err == nil {
+				result.Success = true
+				result.Status = req.Status
+			} else if err.Error() == "record not found" {
+				result.Error = map[string]string{
+					"code":    "SUBMISSION_NOT_FOUND",
+					"message": "Submission not found",
+				}
+			} else {
+				result.Error = map[string]string{
+					"code":    "REVIEW_FAILED",
+					"message": err.Error(),
+				}
+			}
+		}
+
+		seen[submissionID] = true
+		results = append(results, result)
+	}
+
+	return results
 }
@@ -0,0 +1,83 @@
+package services
+
+// SubmissionViewer identifies who is looking at a submission
+type SubmissionViewer int
+
+const (
+	// ViewerPublic is anyone who knows the submission ID
+	ViewerPublic SubmissionViewer = iota
+	// ViewerOwner presented the submission's secret token
+	ViewerOwner
+	// ViewerAdmin is authenticated with an admin JWT
+	ViewerAdmin
+)
+
+// String returns the name used for the viewer in API responses
+func (v SubmissionViewer) String() string {
+	switch v {
+	case ViewerAdmin:
+		return "admin"
+	case ViewerOwner:
+		return "owner"
+	default:
+		return "public"
+	}
+}
+
+// submissionFieldRule hides a field from viewers below minViewer
+type submissionFieldRule struct {
+	field     string
+	minViewer SubmissionViewer
+	clear     func(s *SubmissionWithTeamMembers)
+}
+
+// submissionFieldRules is the single source of truth for submission field visibility.
+// Fields not listed here (ID, project name, event, status and timeline timestamps) are public.
+var submissionFieldRules = []submissionFieldRule{
+	{"description", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.Description = "" }},
+	{"photoLink", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.PhotoLink = "" }},
+	{"categories", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.Categories = nil }},
+	{"teamMembers", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.TeamMembers = nil }},
+	{"githubLink", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.GithubLink = nil }},
+	{"websiteLink", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.WebsiteLink = nil }},
+	{"playLink", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.PlayLink = "" }},
+	{"howToPlay", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.HowToPlay = "" }},
+	{"additionalNotes", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.AdditionalNotes = nil }},
+	{"extraFields", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.ExtraFields = nil }},
+	{"contractAddresses", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.ContractAddresses = nil }},
+	{"feedback", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.Feedback = nil }},
+	{"changesRequested", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.ChangesRequested = nil }},
+	{"ownerAddress", ViewerOwner, func(s *SubmissionWithTeamMembers) { s.OwnerAddress = nil }},
+	{"reviewerId", ViewerAdmin, func(s *SubmissionWithTeamMembers) { s.ReviewerID = nil }},
+	{"unpublishedProject", ViewerOwner, func(s *SubmissionWithTeamMembers) {
+		// Approved projects stay hidden from the public until their scheduled reveal
+		if s.PublishedAt == nil {
+			s.ApprovedProject = nil
+			s.ApprovedProjectID = nil
+		}
+	}},
+	{"slaDueAt", ViewerAdmin, func(s *SubmissionWithTeamMembers) { s.SLADueAt = nil }},
+	{"overdue", ViewerAdmin, func(s *SubmissionWithTeamMembers) { s.Overdue = false }},
+	{"externalSource", ViewerAdmin, func(s *SubmissionWithTeamMembers) { s.ExternalSource = nil }},
+	{"externalId", ViewerAdmin, func(s *SubmissionWithTeamMembers) { s.ExternalID = nil }},
+}
+
+// RedactFor clears every field the viewer is not allowed to see
+func (s *SubmissionWithTeamMembers) RedactFor(viewer SubmissionViewer) {
+	for _, rule := range submissionFieldRules {
+		if viewer < rule.minViewer {
+			rule.clear(s)
+		}
+	}
+}
+
+// RedactedSubmissionFields lists the fields hidden from the viewer
+func RedactedSubmissionFields(viewer SubmissionViewer) []string {
+	fields := []string{}
+	for _, rule := range submissionFieldRules {
+		if viewer < rule.minViewer {
+			fields = append(fields, rule.field)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,193 @@
+// Package siwe parses and verifies Sign-In with Ethereum (EIP-4361) messages offline.
+package siwe
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const headerSuffix = " wants you to sign in with your Ethereum account:"
+
+// nonceRe matches the EIP-4361 nonce: at least 8 alphanumeric characters
+var nonceRe = regexp.MustCompile(`^[A-Za-z0-9]{8,}$`)
+
+// Message is a parsed EIP-4361 message
+type Message struct {
+	Scheme         string // Optional, e.g. "https"
+	Domain         string
+	Address        string // EIP-55 checksummed
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        int64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime *time.Time
+	NotBefore      *time.Time
+	RequestID      string
+	Resources      []string
+}
+
+// Parse parses the text of an EIP-4361 message
+func Parse(text string) (*Message, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	p := &parser{lines: lines}
+	msg := &Message{}
+
+	header, ok := p.next()
+	if !ok || !strings.HasSuffix(header, headerSuffix) {
+		return nil, errors.New("missing sign-in header")
+	}
+	msg.Domain = strings.TrimSuffix(header, headerSuffix)
+	if scheme, domain, found := strings.Cut(msg.Domain, "://"); found {
+		msg.Scheme, msg.Domain = scheme, domain
+	}
+	if msg.Domain == "" || strings.ContainsAny(msg.Domain, " /") {
+		return nil, errors.New("invalid domain")
+	}
+
+	msg.Address, _ = p.next()
+	if !IsAddress(msg.Address) || ChecksumAddress(msg.Address) != msg.Address {
+		return nil, errors.New("address must be an EIP-55 checksummed address")
+	}
+
+	// An empty line, an optional statement and another empty line. Some clients leave out the
+	// second empty line when there is no statement.
+	if line, _ := p.next(); line != "" {
+		return nil, errors.New("expected an empty line after the address")
+	}
+	if line, ok := p.peek(); ok && !strings.HasPrefix(line, "URI: ") {
+		p.next()
+		if line != "" {
+			msg.Statement = line
+			if line, _ := p.next(); line != "" {
+				return nil, errors.New("expected an empty line after the statement")
+			}
+		}
+	}
+
+	var err error
+	if msg.URI, err = p.field("URI", true); err != nil {
+		return nil, err
+	}
+	if msg.Version, err = p.field("Version", true); err != nil {
+		return nil, err
+	}
+	if msg.Version != "1" {
+		return nil, errors.New("unsupported version")
+	}
+
+	chainID, err := p.field("Chain ID", true)
+	if err != nil {
+		return nil, err
+	}
+	if msg.ChainID, err = strconv.ParseInt(chainID, 10, 64); err != nil || msg.ChainID <= 0 {
+		return nil, errors.New("invalid chain ID")
+	}
+
+	if msg.Nonce, err = p.field("Nonce", true); err != nil {
+		return nil, err
+	}
+	if !nonceRe.MatchString(msg.Nonce) {
+		return nil, errors.New("nonce must be at least 8 alphanumeric characters")
+	}
+
+	issuedAt, err := p.field("Issued At", true)
+	if err != nil {
+		return nil, err
+	}
+	if msg.IssuedAt, err = time.Parse(time.RFC3339Nano, issuedAt); err != nil {
+		return nil, errors.New("invalid Issued At time")
+	}
+
+	if msg.ExpirationTime, err = p.timeField("Expiration Time"); err != nil {
+		return nil, err
+	}
+	if msg.NotBefore, err = p.timeField("Not Before"); err != nil {
+		return nil, err
+	}
+	if msg.RequestID, err = p.field("Request ID", false); err != nil {
+		return nil, err
+	}
+
+	if line, ok := p.peek(); ok && line == "Resources:" {
+		p.next()
+		for {
+			line, ok := p.peek()
+			if !ok || !strings.HasPrefix(line, "- ") {
+				break
+			}
+			p.next()
+			msg.Resources = append(msg.Resources, strings.TrimPrefix(line, "- "))
+		}
+	}
+
+	// Only a trailing newline may follow
+	for {
+		line, ok := p.next()
+		if !ok {
+			break
+		}
+		if line != "" {
+			return nil, fmt.Errorf("unexpected line %q", line)
+		}
+	}
+
+	return msg, nil
+}
+
+// parser walks the lines of a message
+type parser struct {
+	lines []string
+	pos   int
+}
+
+func (p *parser) peek() (string, bool) {
+	if p.pos >= len(p.lines) {
+		return "", false
+	}
+	return p.lines[p.pos], true
+}
+
+func (p *parser) next() (string, bool) {
+	line, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return line, ok
+}
+
+// field reads a "Name: value" line; optional fields that are absent yield ""
+func (p *parser) field(name string, required bool) (string, error) {
+	line, ok := p.peek()
+	if !ok || !strings.HasPrefix(line, name+": ") {
+		if required {
+			return "", fmt.Errorf("missing %s", name)
+		}
+		return "", nil
+	}
+	p.next()
+
+	value := strings.TrimPrefix(line, name+": ")
+	if value == "" {
+		return "", fmt.Errorf("empty %s", name)
+	}
+	return value, nil
+}
+
+// timeField reads an optional RFC 3339 time field
+func (p *parser) timeField(name string) (*time.Time, error) {
+	value, err := p.field(name, false)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s time", name)
+	}
+	return &t, nil
+}
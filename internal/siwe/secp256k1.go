@@ -0,0 +1,117 @@
+package siwe
+
+import (
+	"errors"
+	"math/big"
+)
+
+// secp256k1 domain parameters (SEC 2, section 2.4.1)
+var (
+	curveP, _  = new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEFFFFFC2F", 16)
+	curveN, _  = new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141", 16)
+	curveGx, _ = new(big.Int).SetString("79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798", 16)
+	curveGy, _ = new(big.Int).SetString("483ADA7726A3C4655DA4FBFC0E1108A8FD17B448A68554199C47D08FFB10D4B8", 16)
+	curveB     = big.NewInt(7)
+
+	// sqrtExp is (p+1)/4; p ≡ 3 (mod 4), so a^sqrtExp is a square root of a when one exists
+	sqrtExp = new(big.Int).Rsh(new(big.Int).Add(curveP, big.NewInt(1)), 2)
+)
+
+// point is an affine point on secp256k1; a nil x marks the point at infinity
+type point struct {
+	x, y *big.Int
+}
+
+func (pt point) infinity() bool {
+	return pt.x == nil
+}
+
+// add returns a+b
+func add(a, b point) point {
+	if a.infinity() {
+		return b
+	}
+	if b.infinity() {
+		return a
+	}
+
+	var lambda *big.Int
+	if a.x.Cmp(b.x) == 0 {
+		sum := new(big.Int).Add(a.y, b.y)
+		if sum.Mod(sum, curveP).Sign() == 0 {
+			return point{}
+		}
+		// Tangent slope: 3x² / 2y
+		num := new(big.Int).Mul(a.x, a.x)
+		num.Mul(num, big.NewInt(3))
+		den := new(big.Int).Lsh(a.y, 1)
+		lambda = num.Mul(num, den.ModInverse(den.Mod(den, curveP), curveP))
+	} else {
+		// Chord slope: (y2-y1) / (x2-x1)
+		num := new(big.Int).Sub(b.y, a.y)
+		den := new(big.Int).Sub(b.x, a.x)
+		lambda = num.Mul(num, den.ModInverse(den.Mod(den, curveP), curveP))
+	}
+	lambda.Mod(lambda, curveP)
+
+	x := new(big.Int).Mul(lambda, lambda)
+	x.Sub(x, a.x).Sub(x, b.x).Mod(x, curveP)
+	y := new(big.Int).Sub(a.x, x)
+	y.Mul(y, lambda).Sub(y, a.y).Mod(y, curveP)
+	return point{x, y}
+}
+
+// multiply returns k·pt by double-and-add
+func multiply(pt point, k *big.Int) point {
+	result := point{}
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		result = add(result, result)
+		if k.Bit(i) == 1 {
+			result = add(result, pt)
+		}
+	}
+	return result
+}
+
+// recoverPublicKey returns the public key that produced the signature (r, s) over hash, where
+// recID selects the parity of R's y coordinate. It returns the 64-byte uncompressed key without its prefix.
+func recoverPublicKey(hash []byte, r, s *big.Int, recID byte) ([]byte, error) {
+	if r.Sign() <= 0 || r.Cmp(curveN) >= 0 || s.Sign() <= 0 || s.Cmp(curveN) >= 0 {
+		return nil, errors.New("signature values out of range")
+	}
+	if recID > 1 {
+		return nil, errors.New("invalid recovery id")
+	}
+
+	// R is the curve point with x = r and the requested y parity
+	y2 := new(big.Int).Exp(r, big.NewInt(3), curveP)
+	y2.Add(y2, curveB).Mod(y2, curveP)
+	y := new(big.Int).Exp(y2, sqrtExp, curveP)
+	check := new(big.Int).Mul(y, y)
+	if check.Mod(check, curveP).Cmp(y2) != 0 {
+		return nil, errors.New("signature r is not on the curve")
+	}
+	if y.Bit(0) != uint(recID) {
+		y.Sub(curveP, y)
+	}
+	R := point{new(big.Int).Set(r), y}
+
+	// Q = r⁻¹(sR - eG)
+	e := new(big.Int).SetBytes(hash)
+	e.Mod(e, curveN)
+	rInv := new(big.Int).ModInverse(r, curveN)
+	u1 := new(big.Int).Neg(e)
+	u1.Mul(u1, rInv).Mod(u1, curveN)
+	u2 := new(big.Int).Mul(s, rInv)
+	u2.Mod(u2, curveN)
+
+	q := add(multiply(point{curveGx, curveGy}, u1), multiply(R, u2))
+	if q.infinity() {
+		return nil, errors.New("recovered point at infinity")
+	}
+
+	key := make([]byte, 64)
+	q.x.FillBytes(key[:32])
+	q.y.FillBytes(key[32:])
+	return key, nil
+}
@@ -0,0 +1,72 @@
+package siwe
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// keccak256 hashes data with the original Keccak padding used by Ethereum
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
+
+// HashPersonalMessage returns the EIP-191 (version 0x45) hash wallets sign for personal_sign
+func HashPersonalMessage(message string) []byte {
+	prefix := "\x19Ethereum Signed Message:\n" + strconv.Itoa(len(message))
+	return keccak256([]byte(prefix), []byte(message))
+}
+
+// RecoverAddress returns the checksummed address whose key produced a personal_sign signature
+// (65 bytes r || s || v, hex encoded) over message
+func RecoverAddress(message, signature string) (string, error) {
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil || len(sig) != 65 {
+		return "", errors.New("signature must be 65 hex-encoded bytes")
+	}
+
+	// Wallets use v = 27/28; some hardware wallets emit the raw recovery id 0/1
+	v := sig[64]
+	if v >= 27 {
+		v -= 27
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	key, err := recoverPublicKey(HashPersonalMessage(message), r, s, v)
+	if err != nil {
+		return "", err
+	}
+	return ChecksumAddress("0x" + hex.EncodeToString(keccak256(key)[12:])), nil
+}
+
+// ChecksumAddress returns the EIP-55 mixed-case form of a 0x-prefixed hex address
+func ChecksumAddress(address string) string {
+	lower := strings.ToLower(strings.TrimPrefix(address, "0x"))
+	hash := hex.EncodeToString(keccak256([]byte(lower)))
+
+	out := []byte(lower)
+	for i, c := range out {
+		if c >= 'a' && c <= 'f' && hash[i] >= '8' {
+			out[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(out)
+}
+
+// IsAddress reports whether s is a 0x-prefixed 20-byte hex address
+func IsAddress(s string) bool {
+	if len(s) != 42 || !strings.HasPrefix(s, "0x") {
+		return false
+	}
+	_, err := hex.DecodeString(s[2:])
+	return err == nil
+}
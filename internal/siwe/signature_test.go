@@ -0,0 +1,138 @@
+package siwe
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// Known vector from the web3.js accounts documentation
+const (
+	testKey       = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	testAddress   = "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23"
+	testMessage   = "Some data"
+	testHash      = "1da44b586eb0729ff70a73c326926f6ed5a25f5b056e7f47fbc6e58d86871655"
+	testSignature = "0xb91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c"
+)
+
+// sign produces a personal_sign signature over message with the private key d and nonce k
+func sign(t *testing.T, d, k *big.Int, message string) string {
+	t.Helper()
+	R := multiply(point{curveGx, curveGy}, k)
+	r := new(big.Int).Mod(R.x, curveN)
+	e := new(big.Int).SetBytes(HashPersonalMessage(message))
+	s := new(big.Int).Mul(r, d)
+	s.Add(s, e).Mul(s, new(big.Int).ModInverse(k, curveN)).Mod(s, curveN)
+
+	sig := make([]byte, 65)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = 27 + byte(R.y.Bit(0))
+	return "0x" + hex.EncodeToString(sig)
+}
+
+func TestHashPersonalMessage(t *testing.T) {
+	if got := hex.EncodeToString(HashPersonalMessage(testMessage)); got != testHash {
+		t.Errorf("hash = %s, want %s", got, testHash)
+	}
+}
+
+func TestRecoverAddress(t *testing.T) {
+	d, _ := new(big.Int).SetString(testKey, 16)
+	siweMessage := "example.com wants you to sign in with your Ethereum account:\n" + testAddress + "\n\nSign in\n\nURI: https://example.com\nVersion: 1\nChain ID: 10143\nNonce: abcdef123456\nIssued At: 2024-01-01T00:00:00Z"
+
+	rawV := []byte(strings.TrimPrefix(testSignature, "0x"))
+	rawV[len(rawV)-2], rawV[len(rawV)-1] = '0', '1'
+	flippedV := []byte(testSignature)
+	flippedV[len(flippedV)-1] = 'b'
+
+	tests := []struct {
+		name      string
+		message   string
+		signature string
+		want      string
+		wantErr   string
+	}{
+		{name: "known vector", message: testMessage, signature: testSignature, want: testAddress},
+		{name: "uppercase hex", message: testMessage, signature: "0x" + strings.ToUpper(testSignature[2:]), want: testAddress},
+		{name: "raw recovery id", message: testMessage, signature: string(rawV), want: testAddress},
+		{name: "siwe message", message: siweMessage, signature: sign(t, d, big.NewInt(123456789), siweMessage), want: testAddress},
+		{name: "other nonce", message: testMessage, signature: sign(t, d, big.NewInt(987654321), testMessage), want: testAddress},
+		{name: "other message", message: "Some datA", signature: testSignature, want: "!" + testAddress},
+		{name: "wrong recovery id", message: testMessage, signature: string(flippedV), want: "!" + testAddress},
+		{name: "too short", message: testMessage, signature: testSignature[:130], wantErr: "signature must be 65 hex-encoded bytes"},
+		{name: "not hex", message: testMessage, signature: "0x" + strings.Repeat("zz", 65), wantErr: "signature must be 65 hex-encoded bytes"},
+		{name: "zero r", message: testMessage, signature: "0x" + strings.Repeat("00", 32) + testSignature[66:], wantErr: "signature values out of range"},
+		{name: "s not below n", message: testMessage, signature: testSignature[:66] + strings.Repeat("ff", 32) + "1c", wantErr: "signature values out of range"},
+		{name: "bad recovery id", message: testMessage, signature: testSignature[:130] + "1d", wantErr: "invalid recovery id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RecoverAddress(tt.message, tt.signature)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if notWant, ok := strings.CutPrefix(tt.want, "!"); ok {
+				// A valid signature over different data recovers some other key, or none at all
+				if err == nil && got == notWant {
+					t.Errorf("recovered %s for a signature it did not make", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("address = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChecksumAddress(t *testing.T) {
+	// EIP-55 test vectors
+	tests := []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+		"0x52908400098527886E0F7030069857D2E4169EE7",
+		"0xde709f2102306220921060314715629080e2fb77",
+	}
+
+	for _, want := range tests {
+		t.Run(want, func(t *testing.T) {
+			for _, input := range []string{want, strings.ToLower(want), "0x" + strings.ToUpper(want[2:])} {
+				if got := ChecksumAddress(input); got != want {
+					t.Errorf("ChecksumAddress(%s) = %s, want %s", input, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIsAddress(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{testAddress, true},
+		{strings.ToLower(testAddress), true},
+		{testAddress[2:], false},
+		{testAddress[:41], false},
+		{testAddress + "0", false},
+		{"0x" + strings.Repeat("g", 40), false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsAddress(tt.input); got != tt.want {
+			t.Errorf("IsAddress(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Package storage stores uploaded media files on the local filesystem or in an S3-compatible bucket.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"monad-devhub-be/internal/config"
+)
+
+// Storage stores files under slash-separated keys and serves them from public URLs
+type Storage interface {
+	// Put stores size bytes read from body under key, replacing any existing file
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Delete removes the file stored under key; missing files are not an error
+	Delete(ctx context.Context, key string) error
+	// URL returns the public URL of the file stored under key
+	URL(key string) string
+}
+
+// New creates the storage backend selected by the configuration
+func New(cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Driver {
+	case "local":
+		return NewLocalStorage(cfg.LocalDir, cfg.PublicURL)
+	case "s3":
+		return NewS3Storage(S3Options{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			ForcePathStyle:  cfg.S3ForcePathStyle,
+			PublicURL:       cfg.PublicURL,
+		})
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+}
+
+// KeyFromURL returns the key of a file served by the storage, or false for URLs it doesn't serve
+func KeyFromURL(s Storage, url string) (string, bool) {
+	prefix := s.URL("")
+	if url == "" || !strings.HasPrefix(url, prefix) {
+		return "", false
+	}
+	key := strings.TrimPrefix(url, prefix)
+	return key, validKey(key)
+}
+
+// validKey reports whether a key is a relative path without empty, "." or ".." segments
+func validKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return false
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"time"
+)
+
+// BusinessCalendar knows which days count as business days (weekdays that are not holidays)
+type BusinessCalendar struct {
+	location *time.Location
+	holidays map[string]bool
+}
+
+// NewBusinessCalendar creates a calendar for the given time zone and holiday dates (YYYY-MM-DD)
+func NewBusinessCalendar(location *time.Location, holidays []string) *BusinessCalendar {
+	if location == nil {
+		location = time.UTC
+	}
+
+	holidaySet := make(map[string]bool)
+	for _, holiday := range RemoveEmpty(holidays) {
+		holidaySet[holiday] = true
+	}
+
+	return &BusinessCalendar{
+		location: location,
+		holidays: holidaySet,
+	}
+}
+
+// IsBusinessDay reports whether the day containing t is a business day
+func (c *BusinessCalendar) IsBusinessDay(t time.Time) bool {
+	local := t.In(c.location)
+	if local.Weekday() == time.Saturday || local.Weekday() == time.Sunday {
+		return false
+	}
+	return !c.holidays[local.Format("2006-01-02")]
+}
+
+// BusinessDuration returns how much of the interval between start and end falls on business days
+func (c *BusinessCalendar) BusinessDuration(start, end time.Time) time.Duration {
+	if !end.After(start) {
+		return 0
+	}
+
+	var total time.Duration
+	cursor := start.In(c.location)
+	for cursor.Before(end) {
+		dayStart := time.Date(cursor.Year(), cursor.Month(), cursor.Day(), 0, 0, 0, 0, c.location)
+		nextDay := dayStart.AddDate(0, 0, 1)
+
+		segmentEnd := nextDay
+		if end.Before(segmentEnd) {
+			segmentEnd = end
+		}
+		if c.IsBusinessDay(cursor) {
+			total += segmentEnd.Sub(cursor)
+		}
+		cursor = nextDay
+	}
+
+	return total
+}
+
+// BusinessDays returns the business time between start and end expressed in days
+func (c *BusinessCalendar) BusinessDays(start, end time.Time) float64 {
+	return c.BusinessDuration(start, end).Hours() / 24
+}
+
+// AddBusinessDays returns the moment at which the given number of business days have elapsed after start
+func (c *BusinessCalendar) AddBusinessDays(start time.Time, days int) time.Time {
+	remaining := time.Duration(days) * 24 * time.Hour
+	cursor := start.In(c.location)
+
+	for remaining > 0 {
+		dayStart := time.Date(cursor.Year(), cursor.Month(), cursor.Day(), 0, 0, 0, 0, c.location)
+		nextDay := dayStart.AddDate(0, 0, 1)
+
+		if c.IsBusinessDay(cursor) {
+			available := nextDay.Sub(cursor)
+			if remaining <= available {
+				return cursor.Add(remaining)
+			}
+			remaining -= available
+		}
+		cursor = nextDay
+	}
+
+	return cursor
+}
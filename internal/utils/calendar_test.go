@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+// Wednesday 2024-05-01 is a holiday in the test calendar; 2024-05-04/05 is a weekend
+var testCalendar = NewBusinessCalendar(time.UTC, []string{"2024-05-01", " ", "2024-12-25 "})
+
+func date(day, hour int) time.Time {
+	return time.Date(2024, 5, day, hour, 0, 0, 0, time.UTC)
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want bool
+	}{
+		{name: "weekday", t: date(2, 9), want: true},
+		{name: "holiday", t: date(1, 9), want: false},
+		{name: "trimmed holiday", t: time.Date(2024, 12, 25, 9, 0, 0, 0, time.UTC), want: false},
+		{name: "saturday", t: date(4, 9), want: false},
+		{name: "sunday", t: date(5, 23), want: false},
+		{name: "monday", t: date(6, 0), want: true},
+		{name: "friday night", t: date(3, 23), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testCalendar.IsBusinessDay(tt.t); got != tt.want {
+				t.Errorf("IsBusinessDay(%v) = %v, want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+
+	// The day is taken in the calendar's time zone
+	ahead := NewBusinessCalendar(time.FixedZone("UTC+8", 8*60*60), nil)
+	if ahead.IsBusinessDay(date(3, 23)) {
+		t.Error("Friday 23:00 UTC is Saturday in UTC+8 and must not be a business day")
+	}
+}
+
+func TestBusinessDuration(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       time.Duration
+	}{
+		{name: "same day", start: date(2, 9), end: date(2, 17), want: 8 * time.Hour},
+		{name: "end before start", start: date(2, 17), end: date(2, 9), want: 0},
+		{name: "empty interval", start: date(2, 9), end: date(2, 9), want: 0},
+		{name: "overnight", start: date(2, 20), end: date(3, 4), want: 8 * time.Hour},
+		{name: "over the weekend", start: date(3, 12), end: date(6, 12), want: 24 * time.Hour},
+		{name: "across a holiday", start: time.Date(2024, 4, 30, 12, 0, 0, 0, time.UTC), end: date(2, 12), want: 24 * time.Hour},
+		{name: "within the weekend", start: date(4, 1), end: date(5, 23), want: 0},
+		{name: "full week", start: date(6, 0), end: date(13, 0), want: 5 * 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testCalendar.BusinessDuration(tt.start, tt.end); got != tt.want {
+				t.Errorf("BusinessDuration(%v, %v) = %v, want %v", tt.start, tt.end, got, tt.want)
+			}
+		})
+	}
+
+	if got := testCalendar.BusinessDays(date(3, 12), date(6, 18)); got != 1.25 {
+		t.Errorf("BusinessDays = %v, want 1.25", got)
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	tests := []struct {
+		name  string
+		start time.Time
+		days  int
+		want  time.Time
+	}{
+		{name: "zero days", start: date(2, 9), days: 0, want: date(2, 9)},
+		{name: "next day", start: date(2, 9), days: 1, want: date(3, 9)},
+		{name: "over the weekend", start: date(3, 9), days: 1, want: date(6, 9)},
+		{name: "over a holiday", start: time.Date(2024, 4, 30, 9, 0, 0, 0, time.UTC), days: 1, want: date(2, 9)},
+		{name: "starting on a weekend", start: date(4, 15), days: 1, want: date(7, 0)},
+		{name: "full week", start: date(6, 9), days: 5, want: date(13, 9)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testCalendar.AddBusinessDays(tt.start, tt.days); !got.Equal(tt.want) {
+				t.Errorf("AddBusinessDays(%v, %d) = %v, want %v", tt.start, tt.days, got, tt.want)
+			}
+		})
+	}
+}
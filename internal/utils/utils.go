@@ -1,34 +1,68 @@
 package utils
 
 import (
+	"crypto/hmac"
+	cryptorand "crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"fmt"
-	"math/rand"
+	"strconv"
 	"strings"
 	"time"
 )
 
-// GenerateSubmissionID generates a unique submission ID in the format SUB-{timestamp}-{randomHash}
-func GenerateSubmissionID() string {
-	timestamp := time.Now().UnixMilli() // 1749035470531
-	randomHash := generateRandomHash(6) // 4W6UZJ
-	return fmt.Sprintf("SUB-%d-%s", timestamp, randomHash)
-}
+// submissionIDCharset is the alphabet used for the random hash and check character of submission IDs
+const submissionIDCharset = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 
-// generateRandomHash generates a random alphanumeric hash of specified length
-func generateRandomHash(length int) string {
-	const charset = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+// GenerateSubmissionID generates a unique submission ID in the format SUB-{timestamp}-{randomHash}{checkChar}
+func GenerateSubmissionID() (string, error) {
+	timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10) // 1749035470531
+	randomHash, err := generateRandomHash(6)                   // 4W6UZJ
+	if err != nil {
+		return "", err
+	}
+	checkChar := submissionIDCheckChar(timestamp + randomHash) // Q
+	return fmt.Sprintf("SUB-%s-%s%c", timestamp, randomHash, checkChar), nil
+}
 
-	// Seed random number generator
-	rand.Seed(time.Now().UnixNano())
+// generateRandomHash generates a cryptographically random alphanumeric hash of specified length
+func generateRandomHash(length int) (string, error) {
+	// Rejection sampling keeps every character equally likely
+	const maxUnbiased = 256 - 256%len(submissionIDCharset)
 
 	var result strings.Builder
-	for i := 0; i < length; i++ {
-		result.WriteByte(charset[rand.Intn(len(charset))])
+	buf := make([]byte, 1)
+	for result.Len() < length {
+		if _, err := cryptorand.Read(buf); err != nil {
+			return "", err
+		}
+		if int(buf[0]) >= maxUnbiased {
+			continue
+		}
+		result.WriteByte(submissionIDCharset[int(buf[0])%len(submissionIDCharset)])
 	}
-	return result.String()
+	return result.String(), nil
 }
 
-// ValidateSubmissionID validates the format of a submission ID
+// submissionIDCheckChar computes a Luhn mod 36 check character, which catches every
+// single-character typo and most swapped adjacent characters
+func submissionIDCheckChar(input string) byte {
+	n := len(submissionIDCharset)
+	factor := 2
+	sum := 0
+	for i := len(input) - 1; i >= 0; i-- {
+		codePoint := strings.IndexByte(submissionIDCharset, input[i])
+		addend := factor * codePoint
+		factor = 3 - factor
+		addend = addend/n + addend%n
+		sum += addend
+	}
+	return submissionIDCharset[(n-sum%n)%n]
+}
+
+// ValidateSubmissionID validates the format of a submission ID.
+// Current IDs carry a check character that is verified; legacy 6-character hashes are still accepted.
 func ValidateSubmissionID(submissionID string) bool {
 	parts := strings.Split(submissionID, "-")
 	if len(parts) != 3 {
@@ -41,15 +75,34 @@ func ValidateSubmissionID(submissionID string) bool {
 	}
 
 	// Check timestamp part (should be numeric)
-	if len(parts[1]) < 10 {
+	if len(parts[1]) < 10 || !isDigits(parts[1]) {
 		return false
 	}
 
-	// Check hash part (should be 6 characters)
-	if len(parts[2]) != 6 {
+	// Check hash part (6 characters for legacy IDs, 6 plus a check character for current IDs)
+	hash := parts[2]
+	for i := 0; i < len(hash); i++ {
+		if strings.IndexByte(submissionIDCharset, hash[i]) < 0 {
+			return false
+		}
+	}
+	switch len(hash) {
+	case 6:
+		return true
+	case 7:
+		return submissionIDCheckChar(parts[1]+hash[:6]) == hash[6]
+	default:
 		return false
 	}
+}
 
+// isDigits reports whether s consists only of ASCII digits
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
 	return true
 }
 
@@ -74,32 +127,85 @@ func RemoveEmpty(slice []string) []string {
 	return result
 }
 
-// ValidateCategories validates that all categories are in the allowed list
-func ValidateCategories(categories []string) bool {
-	allowedCategories := []string{
-		"DeFi", "Gaming", "AI", "Infrastructure", "Consumer", "NFT", "Stablecoins",
+// RemoveDuplicates removes repeated strings from a slice, keeping the first occurrence
+func RemoveDuplicates(slice []string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, s := range slice {
+		if !seen[s] {
+			seen[s] = true
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// Slugify converts a display name into a URL-safe slug ("Mission: 1 Crazy Contract" -> "mission-1-crazy-contract")
+func Slugify(name string) string {
+	var slug strings.Builder
+	pendingDash := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if pendingDash && slug.Len() > 0 {
+				slug.WriteByte('-')
+			}
+			slug.WriteRune(r)
+			pendingDash = false
+		} else {
+			pendingDash = true
+		}
 	}
+	return slug.String()
+}
 
-	for _, category := range categories {
-		if !Contains(allowedCategories, category) {
-			return false
+// ValidateSlug validates that a slug consists of lowercase words separated by single dashes
+func ValidateSlug(slug string) bool {
+	return slug != "" && Slugify(slug) == slug
+}
+
+// TrimTwitterHandle reduces twitter profile URLs and @handles to the bare handle, keeping its case
+func TrimTwitterHandle(value string) string {
+	value = strings.TrimSpace(value)
+	for _, prefix := range []string{"https://", "http://", "www.", "mobile.", "twitter.com/", "x.com/"} {
+		if len(value) >= len(prefix) && strings.EqualFold(value[:len(prefix)], prefix) {
+			value = value[len(prefix):]
 		}
 	}
-	return true
+	value = strings.TrimPrefix(value, "@")
+	if i := strings.IndexAny(value, "/?#"); i >= 0 {
+		value = value[:i]
+	}
+	return value
 }
 
-// ValidateEvents validates that the event is in the allowed list
-func ValidateEvent(event string) bool {
-	allowedEvents := []string{
-		"Mission: 1 Crazy Contract",
-		"Mission: 2 MCP Madness",
-		"Mission: 3 Break Monad V2",
-		"Mission: 4 Visualizer & Dashboard",
-		"Hackathon",
-		"Free Will!!!",
+// NormalizeTwitterHandle returns the lowercase bare handle that identifies a builder,
+// or "" when the value is not a plausible handle (letters, digits and underscores only)
+func NormalizeTwitterHandle(value string) string {
+	handle := strings.ToLower(TrimTwitterHandle(value))
+	if handle == "" || len(handle) > 50 {
+		return ""
+	}
+	for _, r := range handle {
+		if !((r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_') {
+			return ""
+		}
 	}
+	return handle
+}
 
-	return Contains(allowedEvents, event)
+// NormalizeAddress returns the lowercase form of a 0x-prefixed EVM address,
+// or "" when the value is not 40 hex characters
+func NormalizeAddress(value string) string {
+	address := strings.ToLower(strings.TrimSpace(value))
+	if len(address) != 42 || !strings.HasPrefix(address, "0x") {
+		return ""
+	}
+	for _, r := range address[2:] {
+		if !((r >= 'a' && r <= 'f') || (r >= '0' && r <= '9')) {
+			return ""
+		}
+	}
+	return address
 }
 
 // ValidateStatus validates submission status
@@ -119,3 +225,98 @@ func ValidateTransactionType(txType string) bool {
 
 	return Contains(allowedTypes, txType)
 }
+
+// GenerateDraftToken generates an unguessable draft token in the format DRAFT-{hex}
+func GenerateDraftToken() (string, error) {
+	buf := make([]byte, 20)
+	if _, err := cryptorand.Read(buf); err != nil {
+		return "", err
+	}
+	return "DRAFT-" + hex.EncodeToString(buf), nil
+}
+
+// ValidateDraftToken validates the format of a draft token
+func ValidateDraftToken(token string) bool {
+	if !strings.HasPrefix(token, "DRAFT-") {
+		return false
+	}
+	raw := strings.TrimPrefix(token, "DRAFT-")
+	if len(raw) != 40 {
+		return false
+	}
+	_, err := hex.DecodeString(raw)
+	return err == nil
+}
+
+// ParseDateParam parses an RFC3339 timestamp or a YYYY-MM-DD date.
+// Plain dates resolve to the start of the day, or the end of the day when endOfDay is set.
+func ParseDateParam(value string, endOfDay bool) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+
+	parsed, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endOfDay {
+		parsed = parsed.Add(24*time.Hour - time.Nanosecond)
+	}
+	return parsed, nil
+}
+
+// GenerateSecretToken generates a random hex token and its SHA-256 hash for storage
+func GenerateSecretToken() (token string, hash string, err error) {
+	buf := make([]byte, 24)
+	if _, err := cryptorand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = hex.EncodeToString(buf)
+	return token, HashSecretToken(token), nil
+}
+
+// HashSecretToken returns the hex-encoded SHA-256 hash of a secret token
+func HashSecretToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// KeyedHash returns the hex-encoded HMAC-SHA256 of a value, used to store identifiers such as
+// IP addresses without keeping them readable
+func KeyedHash(secret, value string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// GenerateDeviceToken generates an anonymous device token in the format DEV-{id}.{signature}.
+// The signature lets the server recognize its own tokens without storing them.
+func GenerateDeviceToken(secret string) (string, error) {
+	buf := make([]byte, 16)
+	if _, err := cryptorand.Read(buf); err != nil {
+		return "", err
+	}
+	id := hex.EncodeToString(buf)
+	return "DEV-" + id + "." + KeyedHash(secret, "device:"+id)[:32], nil
+}
+
+// ParseDeviceToken verifies a device token's signature and returns its ID
+func ParseDeviceToken(secret, token string) (string, bool) {
+	body, signature, found := strings.Cut(strings.TrimPrefix(token, "DEV-"), ".")
+	if !found || !strings.HasPrefix(token, "DEV-") || len(body) != 32 {
+		return "", false
+	}
+	expected := KeyedHash(secret, "device:"+body)[:32]
+	if subtle.ConstantTimeCompare([]byte(signature), []byte(expected)) != 1 {
+		return "", false
+	}
+	return body, true
+}
+
+// SecretTokenMatches compares a presented token against a stored hash in constant time
+func SecretTokenMatches(token, hash string) bool {
+	if token == "" || hash == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(HashSecretToken(token)), []byte(hash)) == 1
+}
@@ -9,8 +9,12 @@ import (
 	"monad-devhub-be/internal/database"
 	"monad-devhub-be/internal/handlers"
 	"monad-devhub-be/internal/middleware"
+	"monad-devhub-be/internal/models"
+	"monad-devhub-be/internal/moderation"
 	"monad-devhub-be/internal/repository"
 	"monad-devhub-be/internal/services"
+	"monad-devhub-be/internal/storage"
+	"monad-devhub-be/internal/utils"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -44,17 +48,82 @@ func main() {
 	projectRepo := repository.NewProjectRepository(db)
 	submissionRepo := repository.NewSubmissionRepository(db)
 	analyticsRepo := repository.NewAnalyticsRepository(db)
+	draftRepo := repository.NewDraftRepository(db)
+	judgingRepo := repository.NewJudgingRepository(db)
+	eventRepo := repository.NewEventRepository(db)
+	categoryRepo := repository.NewCategoryRepository(db)
+	awardRepo := repository.NewAwardRepository(db)
+	mediaRepo := repository.NewMediaRepository(db)
+	builderRepo := repository.NewBuilderRepository(db)
+	contractRepo := repository.NewContractRepository(db)
+	commentRepo := repository.NewCommentRepository(db)
+	likeRepo := repository.NewLikeRepository(db)
+	builderAccountRepo := repository.NewBuilderAccountRepository(db)
+	changeRequestRepo := repository.NewChangeRequestRepository(db)
+	collectionRepo := repository.NewCollectionRepository(db)
+
+	// Initialize media storage
+	mediaStorage, err := storage.New(cfg.Storage)
+	if err != nil {
+		log.Fatalf("Failed to initialize media storage: %v", err)
+	}
 
 	// Initialize services
-	projectService := services.NewProjectService(projectRepo, submissionRepo)
-	submissionService := services.NewSubmissionService(submissionRepo, projectRepo)
+	projectService := services.NewProjectService(projectRepo, submissionRepo, eventRepo, categoryRepo)
+	reviewCalendar := utils.NewBusinessCalendar(cfg.SLALocation, cfg.SLAHolidays)
+	submissionService := services.NewSubmissionService(submissionRepo, projectRepo, awardRepo, eventRepo, reviewCalendar, cfg.ReviewSLADays)
 	analyticsService := services.NewAnalyticsService(analyticsRepo)
+	draftService := services.NewDraftService(draftRepo, projectService, cfg.DraftTTL)
+	reviewMetricsService := services.NewReviewMetricsService(submissionRepo, reviewCalendar, cfg.ReviewSLADays)
+	exportService := services.NewExportService(submissionRepo, projectRepo)
+	importService := services.NewImportService(projectService, submissionRepo, projectRepo, awardRepo)
+	judgingService := services.NewJudgingService(judgingRepo, projectRepo, eventRepo, awardRepo)
+	eventService := services.NewEventService(eventRepo)
+	categoryService := services.NewCategoryService(categoryRepo)
+	awardService := services.NewAwardService(awardRepo, projectRepo, eventRepo)
+	builderService := services.NewBuilderService(builderRepo)
+	contractService := services.NewContractService(contractRepo, projectRepo)
+	commentFilter := moderation.NewFilter(cfg.Comments.BlockedWords, cfg.Comments.AllowLinks)
+	likeService := services.NewLikeService(likeRepo, projectRepo, cfg.JWTSecret, cfg.LikesPerIP)
+	commentService := services.NewCommentService(commentRepo, projectRepo, commentFilter, cfg.Comments.PerMinute, cfg.Comments.PerHourPerIP, cfg.JWTSecret)
+	builderAuthService := services.NewBuilderAuthService(builderAccountRepo, cfg.SIWE.Domains, cfg.SIWE.ChainIDs, middleware.BuilderJWTSecret(), cfg.SIWE.SessionTTL)
+	changeRequestService := services.NewChangeRequestService(changeRequestRepo, projectRepo, cfg.AutoApproveFields)
+	collectionService := services.NewCollectionService(collectionRepo)
+	mediaService := services.NewMediaService(mediaStorage, mediaRepo, projectRepo, cfg.MaxImageBytes, cfg.MaxVideoBytes, cfg.UploadTTL)
+
+	// Publication hooks
+	submissionService.OnPublish(func(project *models.Project) {
+		log.Printf("Project %d (%s) published", project.ID, project.Name)
+	})
+
+	// Purge hooks
+	projectService.OnPurge(mediaService.RemoveProjectFiles)
+
+	// Start background jobs
+	go draftService.RunCleanup(time.Hour)
+	go mediaService.RunUploadCleanup(time.Hour)
+	go submissionService.RunPublishScheduler(time.Minute)
 
 	// Initialize handlers
-	projectHandler := handlers.NewProjectHandler(projectService)
+	projectHandler := handlers.NewProjectHandler(projectService, likeService)
 	analyticsHandler := handlers.NewAnalyticsHandler(analyticsService)
 	submissionHandler := handlers.NewSubmissionHandler(projectService, submissionService)
 	authHandler := handlers.NewAuthHandler(db)
+	draftHandler := handlers.NewDraftHandler(draftService)
+	metricsHandler := handlers.NewMetricsHandler(reviewMetricsService)
+	exportHandler := handlers.NewExportHandler(exportService)
+	importHandler := handlers.NewImportHandler(importService)
+	judgingHandler := handlers.NewJudgingHandler(judgingService)
+	eventHandler := handlers.NewEventHandler(eventService)
+	categoryHandler := handlers.NewCategoryHandler(categoryService)
+	awardHandler := handlers.NewAwardHandler(awardService)
+	mediaHandler := handlers.NewMediaHandler(mediaService)
+	builderHandler := handlers.NewBuilderHandler(builderService)
+	contractHandler := handlers.NewContractHandler(contractService)
+	commentHandler := handlers.NewCommentHandler(commentService)
+	builderAuthHandler := handlers.NewBuilderAuthHandler(builderAuthService)
+	changeRequestHandler := handlers.NewChangeRequestHandler(changeRequestService)
+	collectionHandler := handlers.NewCollectionHandler(collectionService)
 
 	// Setup router
 	router := gin.Default()
@@ -63,8 +132,8 @@ func main() {
 	corsConfig := cors.Config{
 		AllowOrigins:     cfg.CORSOrigins,
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Accept", "Authorization", "X-Requested-With"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "Accept", "Authorization", "X-Requested-With", "X-Submission-Token", "X-Comment-Token", "X-Device-Token", "X-Client-Fingerprint", "If-Match"},
+		ExposeHeaders:    []string{"Content-Length", "Content-Disposition", "ETag"},
 		AllowCredentials: len(cfg.CORSOrigins) == 1 && cfg.CORSOrigins[0] != "*", // Only allow credentials if not wildcard
 		MaxAge:           12 * time.Hour,
 	}
@@ -80,6 +149,11 @@ func main() {
 		c.JSON(200, gin.H{"status": "ok", "service": "monad-devhub-api"})
 	})
 
+	// Uploaded media, when stored on the local filesystem
+	if local, ok := mediaStorage.(*storage.LocalStorage); ok {
+		router.Static("/media", local.Root())
+	}
+
 	// API routes
 	v1 := router.Group("/api/v1")
 	{
@@ -88,32 +162,155 @@ func main() {
 		{
 			auth.POST("/login", authHandler.Login)
 			auth.GET("/verify", authHandler.VerifyToken)
-			auth.POST("/admin", middleware.JWTAuth(), authHandler.CreateAdmin)
+			auth.POST("/admin", middleware.AdminAuth(), authHandler.CreateAdmin)
 			auth.PUT("/change-password", middleware.JWTAuth(), authHandler.ChangePassword)
+			auth.GET("/siwe/nonce", builderAuthHandler.GetNonce)
+			auth.POST("/siwe/verify", builderAuthHandler.SignIn)
+			auth.GET("/me", middleware.RoleAuth("builder"), builderAuthHandler.GetMe)
 		}
 
 		// Projects routes
 		projects := v1.Group("/projects")
 		{
-			projects.GET("", projectHandler.GetProjects)
-			projects.POST("", projectHandler.CreateProject)
-			projects.GET("/:id", projectHandler.GetProject)
-			projects.POST("/:id/like", projectHandler.LikeProject)
+			projects.GET("", middleware.OptionalJWTAuth(), projectHandler.GetProjects)
+			projects.GET("/featured", middleware.OptionalJWTAuth(), projectHandler.GetFeaturedProjects)
+			projects.GET("/:id", middleware.OptionalJWTAuth(), projectHandler.GetProject)
+			projects.GET("/:id/onchain", contractHandler.GetProjectOnchain)
+			projects.POST("/:id/like", middleware.OptionalJWTAuth(), projectHandler.LikeProject)
+			projects.DELETE("/:id/like", middleware.OptionalJWTAuth(), projectHandler.UnlikeProject)
+			projects.GET("/:id/comments", commentHandler.GetComments)
+			projects.POST("/:id/comments", middleware.OptionalJWTAuth(), commentHandler.CreateComment)
+			projects.DELETE("/:id/comments/:commentId", middleware.OptionalJWTAuth(), commentHandler.DeleteComment)
+			projects.GET("/:id/change-requests", middleware.RoleAuth("builder"), changeRequestHandler.GetOwnerChangeRequests)
+			projects.POST("/:id/change-requests", middleware.RoleAuth("builder"), changeRequestHandler.ProposeChanges)
+			projects.POST("/:id/change-requests/:requestId/withdraw", middleware.RoleAuth("builder"), changeRequestHandler.WithdrawChangeRequest)
+		}
+
+		// Events routes
+		events := v1.Group("/events")
+		{
+			events.GET("", eventHandler.GetEvents)
+			events.GET("/:id", eventHandler.GetEvent)
+			events.GET("/:id/form-schema", eventHandler.GetFormSchema)
 		}
 
+		// Collections routes
+		collections := v1.Group("/collections")
+		{
+			collections.GET("", collectionHandler.GetCollections)
+			collections.GET("/:slug", collectionHandler.GetCollection)
+		}
+
+		// Categories routes
+		v1.GET("/categories", categoryHandler.GetCategories)
+
+		// Builders routes
+		builders := v1.Group("/builders")
+		{
+			builders.GET("", builderHandler.GetBuilders)
+			builders.GET("/:handle", builderHandler.GetBuilder)
+		}
+
+		// Upload routes
+		v1.POST("/uploads/images", middleware.RateLimit(cfg.UploadsPerMinute), mediaHandler.UploadImage)
+
+		// Awards routes
+		v1.GET("/awards", awardHandler.GetAwards)
+
 		// Submissions routes
 		submissions := v1.Group("/submissions")
 		{
-			submissions.POST("", submissionHandler.SubmitProject)
-			submissions.GET("/:submissionId", submissionHandler.GetSubmissionStatus)
-			submissions.GET("", submissionHandler.GetSubmissions)
-			submissions.PUT("/:submissionId/review", middleware.JWTAuth(), submissionHandler.ReviewSubmission)
+			submissions.POST("", middleware.OptionalJWTAuth(), submissionHandler.SubmitProject)
+			submissions.POST("/drafts", draftHandler.CreateDraft)
+			submissions.GET("/drafts/:draftId", draftHandler.GetDraft)
+			submissions.PUT("/drafts/:draftId", draftHandler.UpdateDraft)
+			submissions.POST("/drafts/:draftId/finalize", draftHandler.FinalizeDraft)
+			submissions.GET("/:submissionId", middleware.OptionalJWTAuth(), submissionHandler.GetSubmissionStatus)
+			submissions.GET("", middleware.AdminAuth(), submissionHandler.GetSubmissions)
+			submissions.PUT("/:submissionId/review", middleware.AdminAuth(), submissionHandler.ReviewSubmission)
 		}
 
-		// Admin routes (now uses JWT auth for write operations)
+		// Admin routes (require an admin token)
 		admin := v1.Group("/admin")
 		{
-			admin.PUT("/submissions/:submissionId/project-extras", middleware.JWTAuth(), submissionHandler.UpdateProjectExtras)
+			admin.PUT("/submissions/:submissionId/project-extras", middleware.AdminAuth(), submissionHandler.UpdateProjectExtras)
+			admin.POST("/submissions/bulk-review", middleware.AdminAuth(), submissionHandler.BulkReviewSubmissions)
+			admin.POST("/submissions/:submissionId/token", middleware.AdminAuth(), submissionHandler.RegenerateSubmissionToken)
+			admin.GET("/metrics/review-sla", middleware.AdminAuth(), metricsHandler.GetReviewSLA)
+			admin.GET("/projects/unpublished", middleware.AdminAuth(), projectHandler.GetUnpublishedProjects)
+			admin.GET("/projects/deleted", middleware.AdminAuth(), projectHandler.GetDeletedProjects)
+			admin.GET("/projects/featured", middleware.AdminAuth(), projectHandler.GetFlaggedFeaturedProjects)
+			admin.PUT("/projects/:id/featured", middleware.AdminAuth(), projectHandler.SetFeatured)
+			admin.GET("/collections", middleware.AdminAuth(), collectionHandler.GetAllCollections)
+			admin.GET("/collections/:id", middleware.AdminAuth(), collectionHandler.GetCollectionForAdmin)
+			admin.POST("/collections", middleware.AdminAuth(), collectionHandler.CreateCollection)
+			admin.PUT("/collections/:id", middleware.AdminAuth(), collectionHandler.UpdateCollection)
+			admin.DELETE("/collections/:id", middleware.AdminAuth(), collectionHandler.DeleteCollection)
+			admin.POST("/projects", middleware.AdminAuth(), projectHandler.CreateProject)
+			admin.GET("/projects/:id", middleware.AdminAuth(), projectHandler.GetProjectPreview)
+			admin.PUT("/projects/:id", middleware.AdminAuth(), projectHandler.UpdateProject)
+			admin.DELETE("/projects/:id", middleware.AdminAuth(), projectHandler.DeleteProject)
+			admin.POST("/projects/:id/restore", middleware.AdminAuth(), projectHandler.RestoreProject)
+			admin.DELETE("/projects/:id/purge", middleware.AdminAuth(), projectHandler.PurgeProject)
+			admin.POST("/projects/:id/team", middleware.AdminAuth(), projectHandler.AddTeamMember)
+			admin.PUT("/projects/:id/team/:memberId", middleware.AdminAuth(), projectHandler.UpdateTeamMember)
+			admin.DELETE("/projects/:id/team/:memberId", middleware.AdminAuth(), projectHandler.RemoveTeamMember)
+			admin.PUT("/projects/:id/owner", middleware.AdminAuth(), builderAuthHandler.SetProjectOwner)
+			admin.GET("/projects/:id/changelog", middleware.AdminAuth(), changeRequestHandler.GetChangeLog)
+			admin.GET("/change-requests", middleware.AdminAuth(), changeRequestHandler.GetChangeRequests)
+			admin.POST("/change-requests/:id/approve", middleware.AdminAuth(), changeRequestHandler.ApproveChangeRequest)
+			admin.POST("/change-requests/:id/reject", middleware.AdminAuth(), changeRequestHandler.RejectChangeRequest)
+			admin.GET("/export/submissions", middleware.AdminAuth(), exportHandler.ExportSubmissions)
+			admin.GET("/export/projects", middleware.AdminAuth(), exportHandler.ExportProjects)
+			admin.POST("/import", middleware.AdminAuth(), importHandler.Import)
+			admin.GET("/events", middleware.AdminAuth(), eventHandler.GetAllEvents)
+			admin.POST("/events", middleware.AdminAuth(), eventHandler.CreateEvent)
+			admin.PUT("/events/:id", middleware.AdminAuth(), eventHandler.UpdateEvent)
+			admin.DELETE("/events/:id", middleware.AdminAuth(), eventHandler.DeleteEvent)
+			admin.POST("/categories", middleware.AdminAuth(), categoryHandler.CreateCategory)
+			admin.PUT("/categories/:id", middleware.AdminAuth(), categoryHandler.UpdateCategory)
+			admin.DELETE("/categories/:id", middleware.AdminAuth(), categoryHandler.DeleteCategory)
+			admin.POST("/projects/:id/logo", middleware.AdminAuth(), mediaHandler.UploadProjectLogo)
+			admin.POST("/projects/:id/team/:memberId/image", middleware.AdminAuth(), mediaHandler.UploadTeamMemberImage)
+			admin.POST("/projects/:id/media", middleware.AdminAuth(), mediaHandler.AddProjectMedia)
+			admin.PUT("/projects/:id/media/order", middleware.AdminAuth(), mediaHandler.ReorderProjectMedia)
+			admin.DELETE("/projects/:id/media/:mediaId", middleware.AdminAuth(), mediaHandler.DeleteProjectMedia)
+			admin.POST("/builders/merge", middleware.AdminAuth(), builderHandler.MergeBuilders)
+			admin.GET("/contracts", middleware.AdminAuth(), contractHandler.GetContractDeclarations)
+			admin.GET("/comments", middleware.AdminAuth(), commentHandler.GetModerationComments)
+			admin.POST("/comments/reconcile", middleware.AdminAuth(), commentHandler.ReconcileCommentCounts)
+			admin.POST("/likes/reconcile", middleware.AdminAuth(), projectHandler.ReconcileLikeCounts)
+			admin.POST("/comments/:id/hide", middleware.AdminAuth(), commentHandler.HideComment)
+			admin.POST("/comments/:id/unhide", middleware.AdminAuth(), commentHandler.UnhideComment)
+			admin.POST("/comments/:id/pin", middleware.AdminAuth(), commentHandler.PinComment)
+			admin.POST("/comments/:id/unpin", middleware.AdminAuth(), commentHandler.UnpinComment)
+			admin.POST("/comments/:id/ban", middleware.AdminAuth(), commentHandler.BanAuthor)
+			admin.GET("/comment-bans", middleware.AdminAuth(), commentHandler.GetBans)
+			admin.DELETE("/comment-bans/:id", middleware.AdminAuth(), commentHandler.LiftBan)
+			admin.GET("/projects/:id/contracts", middleware.AdminAuth(), contractHandler.GetProjectContracts)
+			admin.POST("/projects/:id/contracts", middleware.AdminAuth(), contractHandler.AddProjectContract)
+			admin.POST("/projects/:id/contracts/:contractId/verify", middleware.AdminAuth(), contractHandler.VerifyContract)
+			admin.POST("/projects/:id/contracts/:contractId/reject", middleware.AdminAuth(), contractHandler.RejectContract)
+			admin.DELETE("/projects/:id/contracts/:contractId", middleware.AdminAuth(), contractHandler.DeleteProjectContract)
+			admin.POST("/awards", middleware.AdminAuth(), awardHandler.CreateAward)
+			admin.PUT("/awards/:id", middleware.AdminAuth(), awardHandler.UpdateAward)
+			admin.DELETE("/awards/:id", middleware.AdminAuth(), awardHandler.DeleteAward)
+			admin.POST("/projects/:id/awards", middleware.AdminAuth(), awardHandler.GrantAward)
+			admin.DELETE("/projects/:id/awards/:awardId", middleware.AdminAuth(), awardHandler.RevokeAward)
+			admin.POST("/judges", middleware.AdminAuth(), judgingHandler.CreateJudge)
+			admin.GET("/judges", middleware.AdminAuth(), judgingHandler.GetJudges)
+			admin.PUT("/judges/:judgeId/events", middleware.AdminAuth(), judgingHandler.SetJudgeEvents)
+			admin.PUT("/judging/rubric", middleware.AdminAuth(), judgingHandler.SaveRubric)
+			admin.GET("/judging/leaderboard", middleware.AdminAuth(), judgingHandler.GetLeaderboard)
+			admin.POST("/judging/awards", middleware.AdminAuth(), judgingHandler.AwardTopProjects)
+		}
+
+		// Judging routes
+		judging := v1.Group("/judging")
+		{
+			judging.GET("/rubric", middleware.RoleAuth("admin", "judge"), judgingHandler.GetRubric)
+			judging.GET("/projects", middleware.RoleAuth("judge"), judgingHandler.GetWorklist)
+			judging.PUT("/projects/:id/scores", middleware.RoleAuth("judge"), judgingHandler.SubmitScores)
 		}
 
 		// Analytics routes